@@ -97,6 +97,8 @@ import (
 	gravityparams "github.com/peggyjv/gravity-bridge/module/v6/app/params"
 	v5 "github.com/peggyjv/gravity-bridge/module/v6/app/upgrades/v5"
 	v6 "github.com/peggyjv/gravity-bridge/module/v6/app/upgrades/v6"
+	v7 "github.com/peggyjv/gravity-bridge/module/v6/app/upgrades/v7"
+	v8 "github.com/peggyjv/gravity-bridge/module/v6/app/upgrades/v8"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity"
 	gravityclient "github.com/peggyjv/gravity-bridge/module/v6/x/gravity/client"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
@@ -139,6 +141,8 @@ var (
 				upgradeclient.LegacyProposalHandler,
 				upgradeclient.LegacyCancelProposalHandler,
 				gravityclient.ProposalHandler,
+				gravityclient.ERC20MetadataProposalHandler,
+				gravityclient.IBCDenomMetadataProposalHandler,
 			},
 		),
 		params.AppModuleBasic{},
@@ -205,7 +209,7 @@ type Gravity struct {
 	accountKeeper         authkeeper.AccountKeeper
 	bankKeeper            bankkeeper.Keeper
 	capabilityKeeper      *capabilitykeeper.Keeper
-	stakingKeeper         stakingkeeper.Keeper
+	stakingKeeper         *stakingkeeper.Keeper
 	slashingKeeper        slashingkeeper.Keeper
 	mintKeeper            mintkeeper.Keeper
 	distrKeeper           distrkeeper.Keeper
@@ -321,7 +325,7 @@ func NewGravityApp(
 		app.BlockedAddrs(),
 		authority,
 	)
-	app.stakingKeeper = *stakingkeeper.NewKeeper(
+	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		app.keys[stakingtypes.StoreKey],
 		app.accountKeeper,
@@ -387,6 +391,7 @@ func NewGravityApp(
 		sdk.DefaultPowerReduction,
 		app.ModuleAccountAddressesToNames([]string{}),
 		app.ModuleAccountAddressesToNames([]string{distrtypes.ModuleName}),
+		authority,
 	)
 
 	app.stakingKeeper.SetHooks(
@@ -411,8 +416,9 @@ func NewGravityApp(
 		app.ibcKeeper.ChannelKeeper, app.ibcKeeper.ChannelKeeper, &app.ibcKeeper.PortKeeper,
 		app.accountKeeper, app.bankKeeper, scopedTransferKeeper,
 	)
+	app.gravityKeeper.SetTransferKeeper(app.transferKeeper)
 	transferModule := ibctransfer.NewAppModule(app.transferKeeper)
-	transferIBCModule := ibctransfer.NewIBCModule(app.transferKeeper)
+	transferIBCModule := gravity.NewIBCMiddleware(ibctransfer.NewIBCModule(app.transferKeeper), app.gravityKeeper)
 
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
@@ -512,7 +518,7 @@ func NewGravityApp(
 			app.GetSubspace(distrtypes.ModuleName),
 		),
 		staking.NewAppModule(appCodec,
-			&app.stakingKeeper,
+			app.stakingKeeper,
 			app.accountKeeper,
 			app.bankKeeper,
 			app.GetSubspace(stakingtypes.ModuleName),
@@ -523,7 +529,9 @@ func NewGravityApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		gravity.NewAppModule(
+			appCodec,
 			app.gravityKeeper,
+			app.accountKeeper,
 			app.bankKeeper,
 		),
 	)
@@ -637,7 +645,7 @@ func NewGravityApp(
 			app.GetSubspace(distrtypes.ModuleName),
 		),
 		staking.NewAppModule(appCodec,
-			&app.stakingKeeper,
+			app.stakingKeeper,
 			app.accountKeeper,
 			app.bankKeeper,
 			app.GetSubspace(stakingtypes.ModuleName),
@@ -647,7 +655,9 @@ func NewGravityApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		gravity.NewAppModule(
+			appCodec,
 			app.gravityKeeper,
+			app.accountKeeper,
 			app.bankKeeper,
 		),
 	)
@@ -939,4 +949,20 @@ func (app *Gravity) setupUpgradeHandlers() {
 			app.configurator,
 		),
 	)
+
+	app.upgradeKeeper.SetUpgradeHandler(
+		v7.UpgradeName,
+		v7.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+		),
+	)
+
+	app.upgradeKeeper.SetUpgradeHandler(
+		v8.UpgradeName,
+		v8.CreateUpgradeHandler(
+			app.mm,
+			app.configurator,
+		),
+	)
 }
@@ -15,9 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // TODO: audit this code when we hook up simulations
@@ -71,10 +74,50 @@ func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simty
 			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
 		}
 
-		return appState, simAccs, chainID, genesisTimestamp
+		return addNotBondedPoolBalance(cdc, appState), simAccs, chainID, genesisTimestamp
 	}
 }
 
+// addNotBondedPoolBalance funds the not bonded pool with the tokens of the
+// unbonded genesis validators so that the bank genesis supply checks out
+func addNotBondedPoolBalance(cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	rawState := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(appState, &rawState); err != nil {
+		panic(err)
+	}
+
+	var stakingState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(rawState[stakingtypes.ModuleName], &stakingState)
+
+	notBondedTokens := sdkmath.ZeroInt()
+	for _, val := range stakingState.Validators {
+		if val.Status == stakingtypes.Unbonded {
+			notBondedTokens = notBondedTokens.Add(val.GetTokens())
+		}
+	}
+
+	var bankState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], &bankState)
+
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	for _, balance := range bankState.Balances {
+		if balance.Address == notBondedPool {
+			return appState
+		}
+	}
+	bankState.Balances = append(bankState.Balances, banktypes.Balance{
+		Address: notBondedPool,
+		Coins:   sdk.NewCoins(sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens)),
+	})
+	rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankState)
+
+	bz, err := json.Marshal(rawState)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
 // AppStateRandomizedFn creates calls each module's GenesisState generator function
 // and creates the simulation params
 func AppStateRandomizedFn(
@@ -0,0 +1,4 @@
+package v7
+
+// UpgradeName defines the on-chain upgrade name for the Gravity v7 upgrade
+const UpgradeName = "v7"
@@ -0,0 +1,4 @@
+package v8
+
+// UpgradeName defines the on-chain upgrade name for the Gravity v8 upgrade
+const UpgradeName = "v8"
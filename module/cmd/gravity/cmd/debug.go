@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/simulation"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const (
+	flagDBBackend = "db-backend"
+	flagPrefix    = "prefix"
+)
+
+// DebugCmd returns the SDK debug command extended with the gravity store
+// decoding commands
+func DebugCmd(defaultNodeHome string) *cobra.Command {
+	cmd := debug.Cmd()
+
+	cmd.AddCommand(
+		GravityStoreCmd(),
+		GravityStoreDumpCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// GravityStoreCmd decodes a raw gravity store key and, optionally, its value
+func GravityStoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gravity-store [key-hex] [value-hex]",
+		Short: "Decode a raw gravity store key and value",
+		Long: fmt.Sprintf(`Decode a raw gravity store key, and optionally the value stored under it,
+into a readable form. Outgoing txs and event vote records are printed as JSON.
+
+Example:
+$ %s debug gravity-store 0601000000000000000a
+$ %s debug gravity-store 0a 0000000000000005
+			`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			key, err := decodeHexArg(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+			decodedKey, err := types.DecodeStoreKey(key)
+			if err != nil {
+				return err
+			}
+			cmd.Println("Key:", decodedKey)
+
+			if len(args) == 1 {
+				return nil
+			}
+
+			value, err := decodeHexArg(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid value: %w", err)
+			}
+			decodedValue, err := simulation.DecodeValue(clientCtx.Codec, key, value)
+			if err != nil {
+				return err
+			}
+			cmd.Println("Value:", decodedValue)
+
+			return nil
+		},
+	}
+}
+
+// GravityStoreDumpCmd walks the gravity store of an application database and
+// prints every entry in a readable form
+func GravityStoreDumpCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gravity-store-dump",
+		Short: "Print every entry of the gravity store in an application database",
+		Long: fmt.Sprintf(`Open the application database of a stopped node, or a copy of it, and print
+every key and value of the gravity store in a readable form, one entry per line.
+Entries that cannot be decoded are printed as hex along with the decoding error.
+
+Example:
+$ %s debug gravity-store-dump --home ~/.gravity --height 1200
+$ %s debug gravity-store-dump --prefix 05
+			`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			homeDir, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			backend, err := cmd.Flags().GetString(flagDBBackend)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flags.FlagHeight)
+			if err != nil {
+				return err
+			}
+			prefixArg, err := cmd.Flags().GetString(flagPrefix)
+			if err != nil {
+				return err
+			}
+			prefix, err := decodeHexArg(prefixArg)
+			if err != nil {
+				return fmt.Errorf("invalid prefix: %w", err)
+			}
+
+			db, err := dbm.NewDB("application", dbm.BackendType(backend), filepath.Join(homeDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			cms := store.NewCommitMultiStore(db)
+			cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+			if height > 0 {
+				err = cms.LoadVersion(height)
+			} else {
+				err = cms.LoadLatestVersion()
+			}
+			if err != nil {
+				return err
+			}
+
+			iter := storetypes.KVStorePrefixIterator(cms.GetKVStore(storeKey), prefix)
+			defer iter.Close()
+
+			for ; iter.Valid(); iter.Next() {
+				cmd.Println(decodeStoreEntry(clientCtx, iter.Key(), iter.Value()))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagDBBackend, string(dbm.GoLevelDBBackend), "The database backend of the application database")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Dump the store at a specific height, defaults to the latest height")
+	cmd.Flags().String(flagPrefix, "", "Only dump the keys starting with this hex prefix")
+
+	return cmd
+}
+
+// decodeStoreEntry returns a single line describing a gravity store entry,
+// falling back to hex when the key or value cannot be decoded
+func decodeStoreEntry(clientCtx client.Context, key, value []byte) string {
+	decodedKey, err := types.DecodeStoreKey(key)
+	if err != nil {
+		return fmt.Sprintf("%X %X (%s)", key, value, err)
+	}
+
+	decodedValue, err := simulation.DecodeValue(clientCtx.Codec, key, value)
+	if err != nil {
+		return fmt.Sprintf("%s %X (%s)", decodedKey, value, err)
+	}
+
+	return fmt.Sprintf("%s %s", decodedKey, decodedValue)
+}
+
+// decodeHexArg decodes a hex argument, with or without a 0x prefix
+func decodeHexArg(arg string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(arg, "0x"), "0X"))
+}
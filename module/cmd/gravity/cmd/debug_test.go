@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/app"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func executeDebugCmd(t *testing.T, cmd *cobra.Command, args ...string) string {
+	clientCtx := client.Context{}.WithCodec(app.MakeEncodingConfig().Marshaler)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	buf := bytes.NewBuffer(nil)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs(args)
+
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	return buf.String()
+}
+
+func TestGravityStoreCmd(t *testing.T) {
+	signerSet := types.NewSignerSetTx(10, 100, types.EthereumSigners{})
+	value, err := app.MakeEncodingConfig().Marshaler.MarshalInterface(signerSet)
+	require.NoError(t, err)
+
+	out := executeDebugCmd(t, GravityStoreCmd(),
+		hex.EncodeToString(types.MakeOutgoingTxKey(signerSet.GetStoreIndex())),
+		hex.EncodeToString(value),
+	)
+	require.Contains(t, out, "Key: OutgoingTx/signer_set/10")
+	require.Contains(t, out, `"@type":"/gravity.v1.SignerSetTx"`)
+	require.Contains(t, out, `"nonce":"10"`)
+}
+
+func TestGravityStoreDumpCmd(t *testing.T) {
+	home := t.TempDir()
+	valAddr := sdk.ValAddress(bytes.Repeat([]byte{1}, 20))
+	ethAddr := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	kvStore := cms.GetKVStore(storeKey)
+	kvStore.Set(types.MakeValidatorEthereumAddressKey(valAddr), ethAddr.Bytes())
+	kvStore.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(42))
+	cms.Commit()
+	require.NoError(t, db.Close())
+
+	out := executeDebugCmd(t, GravityStoreDumpCmd(home), "--home", home)
+	require.Contains(t, out, fmt.Sprintf("ValidatorEthereumAddress/%s %s", valAddr, ethAddr.Hex()))
+	require.Contains(t, out, "LastObservedEventNonce 42")
+
+	out = executeDebugCmd(t, GravityStoreDumpCmd(home), "--home", home, "--prefix", "09")
+	require.NotContains(t, out, "ValidatorEthereumAddress")
+	require.Contains(t, out, "LastObservedEventNonce 42")
+}
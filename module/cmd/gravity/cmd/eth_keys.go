@@ -1,22 +1,45 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/cometbft/cometbft/libs/cli"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const (
+	flagPassphrase       = "passphrase"
+	flagImportPassphrase = "import-passphrase"
+	flagExportPassphrase = "export-passphrase"
+	flagSignatureVersion = "signature-version"
+	flagBridgeChainID    = "bridge-chain-id"
+	flagYes              = "yes"
 )
 
-const flagPassphrase = "passphrase"
+// the scrypt parameters of the keystore, lowered by tests
+var (
+	keystoreScryptN = keystore.StandardScryptN
+	keystoreScryptP = keystore.StandardScryptP
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -26,14 +49,19 @@ func Commands(defaultNodeHome string) *cobra.Command {
 		Short: "Manage your application's ethereum keys",
 		Long: `Keyring management commands. Generated by the official Ethereum go library.
 
-The keyring supports the following backends:
-    test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
-                and it should be use only for testing purposes.
+The keys are stored in the keyring directory as encrypted V3 keystore files,
+identified by their Ethereum address and unlocked with --passphrase.
 `,
 	}
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ImportKeyCommand(),
+		ExportKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		DeleteKeyCommand(),
+		SignDelegateKeysCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -94,7 +122,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		ks := keystore.NewKeyStore(clientCtx.KeyringDir, keystore.StandardScryptN, keystore.StandardScryptP)
+		ks := newKeyStore(clientCtx)
 		passphrase, err := cmd.Flags().GetString(flagPassphrase)
 		if err != nil {
 			return err
@@ -128,3 +156,333 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 
 	return nil
 }
+
+// ImportKeyCommand defines a keys command to import a key
+func ImportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [keystore-file]",
+		Short: "Import an ethereum key from a V3 keystore file or a hex private key",
+		Long: `Import an ethereum key and encrypt it to disk with --passphrase. With an
+argument the key is read from a V3 keystore JSON file, which is decrypted with
+--import-passphrase. Otherwise the hex encoded private key is read from stdin,
+or prompted for without echo on a terminal, which keeps it out of the shell
+history and the process list.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+
+			ks := newKeyStore(clientCtx)
+			var account accounts.Account
+			if len(args) == 1 {
+				keyJSON, err := os.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+				importPassphrase, _ := cmd.Flags().GetString(flagImportPassphrase)
+				if account, err = ks.Import(keyJSON, importPassphrase, passphrase); err != nil {
+					return err
+				}
+			} else {
+				// the length of the key is checked when it is decoded
+				hexKey, err := input.GetPassword("Enter the hex encoded private key:", bufio.NewReader(cmd.InOrStdin()))
+				if err != nil && hexKey == "" {
+					return err
+				}
+				privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+				if err != nil {
+					return fmt.Errorf("invalid hex private key: %w", err)
+				}
+				if account, err = ks.ImportECDSA(privateKey, passphrase); err != nil {
+					return err
+				}
+			}
+
+			return printKeyInfo(cmd, account)
+		},
+	}
+
+	cmd.Flags().String(flagPassphrase, "default", "Password used to encrypt the ethereum key on disk")
+	cmd.Flags().String(flagImportPassphrase, "", "Password of the imported V3 keystore file")
+
+	return cmd
+}
+
+// ExportKeyCommand defines a keys command to export a key
+func ExportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [address]",
+		Short: "Export an ethereum key as an encrypted V3 keystore JSON",
+		Long: `Export an ethereum key as a V3 keystore JSON, encrypted with --export-passphrase,
+or with --passphrase if it is not set.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+			exportPassphrase, _ := cmd.Flags().GetString(flagExportPassphrase)
+			if exportPassphrase == "" {
+				exportPassphrase = passphrase
+			}
+
+			ks := newKeyStore(clientCtx)
+			account, err := findKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := ks.Export(account, passphrase, exportPassphrase)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(keyJSON))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPassphrase, "default", "Password used to decrypt the ethereum key on disk")
+	cmd.Flags().String(flagExportPassphrase, "", "Password used to encrypt the exported V3 keystore JSON")
+
+	return cmd
+}
+
+// ListKeysCommand defines a keys command to list the keys
+func ListKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the ethereum keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return printKeyInfos(cmd, newKeyStore(clientCtx).Accounts())
+		},
+	}
+
+	return cmd
+}
+
+// ShowKeyCommand defines a keys command to show a key
+func ShowKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [address]",
+		Short: "Show the keystore file of an ethereum key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			account, err := findKey(newKeyStore(clientCtx), args[0])
+			if err != nil {
+				return err
+			}
+
+			return printKeyInfo(cmd, account)
+		},
+	}
+
+	return cmd
+}
+
+// DeleteKeyCommand defines a keys command to delete a key
+func DeleteKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [address]",
+		Short: "Delete an ethereum key",
+		Long: `Delete an ethereum key from disk. The key must be unlocked with --passphrase.
+Export it first if it may still be needed.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+
+			ks := newKeyStore(clientCtx)
+			account, err := findKey(ks, args[0])
+			if err != nil {
+				return err
+			}
+
+			if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+				buf := bufio.NewReader(cmd.InOrStdin())
+				if yes, err := input.GetConfirmation(fmt.Sprintf("Key %s will be deleted. Continue?", account.Address.Hex()), buf, cmd.ErrOrStderr()); err != nil || !yes {
+					return err
+				}
+			}
+
+			if err := ks.Delete(account, passphrase); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Key %s deleted\n", account.Address.Hex())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPassphrase, "default", "Password used to decrypt the ethereum key on disk")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting the key")
+
+	return cmd
+}
+
+// SignDelegateKeysCommand defines a keys command to sign the delegate keys of a validator
+func SignDelegateKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-delegate-keys [address] [validator-address] [sequence]",
+		Short: "Sign the delegate keys of a validator with an ethereum key",
+		Long: `Produce the ethereum signature of MsgDelegateKeys with a stored ethereum key, for
+the validator and the sequence of the validator account at which the message is
+submitted. With --signature-version 0 the key signs the proto encoded
+DelegateKeysSignMsg, with --signature-version 1 the DelegateKeys EIP-712 typed
+data in the domain of --bridge-chain-id.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			key, err := unlockKey(newKeyStore(clientCtx), args[0], passphrase)
+			if err != nil {
+				return err
+			}
+
+			sigVersion, _ := cmd.Flags().GetUint32(flagSignatureVersion)
+			var signature []byte
+			switch sigVersion {
+			case types.DelegateKeysSignatureVersionProto:
+				signMsgBz, err := (&types.DelegateKeysSignMsg{ValidatorAddress: valAddr.String(), Nonce: nonce}).Marshal()
+				if err != nil {
+					return err
+				}
+				if signature, err = types.NewEthereumSignature(crypto.Keccak256(signMsgBz), key.PrivateKey); err != nil {
+					return err
+				}
+			case types.DelegateKeysSignatureVersionEIP712:
+				if !cmd.Flags().Changed(flagBridgeChainID) {
+					return fmt.Errorf("--%s is required with signature version %d", flagBridgeChainID, sigVersion)
+				}
+				chainID, _ := cmd.Flags().GetUint64(flagBridgeChainID)
+				hash, err := types.DelegateKeysTypedDataHash(valAddr.String(), nonce, chainID)
+				if err != nil {
+					return err
+				}
+				if signature, err = crypto.Sign(hash, key.PrivateKey); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unknown signature version %d", sigVersion)
+			}
+
+			cmd.Println(hexutil.Encode(signature))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPassphrase, "default", "Password used to decrypt the ethereum key on disk")
+	cmd.Flags().Uint32(flagSignatureVersion, types.DelegateKeysSignatureVersionProto, "The signature scheme, 0 for the proto encoded DelegateKeysSignMsg, 1 for EIP-712 typed data")
+	cmd.Flags().Uint64(flagBridgeChainID, 0, "The bridge chain id of the default EVM chain, required with signature version 1")
+
+	return cmd
+}
+
+// EthereumKeyInfo is the output of the commands showing stored ethereum keys
+type EthereumKeyInfo struct {
+	Address string `json:"address"`
+	Path    string `json:"path"`
+}
+
+func newKeyStore(clientCtx client.Context) *keystore.KeyStore {
+	return keystore.NewKeyStore(clientCtx.KeyringDir, keystoreScryptN, keystoreScryptP)
+}
+
+// findKey returns the stored key of the ethereum address
+func findKey(ks *keystore.KeyStore, address string) (accounts.Account, error) {
+	if !common.IsHexAddress(address) {
+		return accounts.Account{}, fmt.Errorf("invalid ethereum address %s", address)
+	}
+	return ks.Find(accounts.Account{Address: common.HexToAddress(address)})
+}
+
+// unlockKey decrypts the stored key of the ethereum address
+func unlockKey(ks *keystore.KeyStore, address, passphrase string) (*keystore.Key, error) {
+	account, err := findKey(ks, address)
+	if err != nil {
+		return nil, err
+	}
+	keyJSON, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.DecryptKey(keyJSON, passphrase)
+}
+
+// printKeyInfo prints a stored key, as a JSON object with --output json
+func printKeyInfo(cmd *cobra.Command, account accounts.Account) error {
+	info := newKeyInfo(account)
+	return printKeyInfoOutput(cmd, info, []EthereumKeyInfo{info})
+}
+
+// printKeyInfos prints the stored keys, as a JSON array with --output json
+// whatever their number
+func printKeyInfos(cmd *cobra.Command, accts []accounts.Account) error {
+	infos := make([]EthereumKeyInfo, len(accts))
+	for i, account := range accts {
+		infos[i] = newKeyInfo(account)
+	}
+	return printKeyInfoOutput(cmd, infos, infos)
+}
+
+func newKeyInfo(account accounts.Account) EthereumKeyInfo {
+	return EthereumKeyInfo{Address: account.Address.Hex(), Path: account.URL.Path}
+}
+
+func printKeyInfoOutput(cmd *cobra.Command, jsonOutput interface{}, infos []EthereumKeyInfo) error {
+	output, _ := cmd.Flags().GetString(cli.OutputFlag)
+
+	switch output {
+	case keys.OutputFormatText:
+		for _, info := range infos {
+			cmd.Printf("address: %s\npath: %s\n", info.Address, info.Path)
+		}
+
+	case keys.OutputFormatJSON:
+		outputBytes, err := json.Marshal(jsonOutput)
+		if err != nil {
+			return err
+		}
+		cmd.Println(string(outputBytes))
+
+	default:
+		return fmt.Errorf("invalid output format %s", output)
+	}
+
+	return nil
+}
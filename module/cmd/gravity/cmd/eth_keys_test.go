@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/app"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func executeEthKeysCmd(t *testing.T, keyringDir string, args ...string) (string, error) {
+	return executeEthKeysCmdWithInput(t, keyringDir, "", args...)
+}
+
+func executeEthKeysCmdWithInput(t *testing.T, keyringDir, stdin string, args ...string) (string, error) {
+	clientCtx := client.Context{}.WithCodec(app.MakeEncodingConfig().Marshaler)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd := Commands(keyringDir)
+	buf := bytes.NewBuffer(nil)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetOut(buf)
+	cmd.SetErr(bytes.NewBuffer(nil))
+	cmd.SetArgs(append(args, "--keyring-dir", keyringDir, "--keyring-backend", "test"))
+
+	err := cmd.ExecuteContext(ctx)
+	return strings.TrimSpace(buf.String()), err
+}
+
+func TestEthKeysCmds(t *testing.T) {
+	keystoreScryptN, keystoreScryptP = keystore.LightScryptN, keystore.LightScryptP
+	defer func() {
+		keystoreScryptN, keystoreScryptP = keystore.StandardScryptN, keystore.StandardScryptP
+	}()
+
+	keyringDir := t.TempDir()
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	// the list is an array even when there are no keys
+	out, err := executeEthKeysCmd(t, keyringDir, "list", "--output", "json")
+	require.NoError(t, err)
+	require.Equal(t, "[]", out)
+
+	// import a hex private key from stdin
+	_, err = executeEthKeysCmdWithInput(t, keyringDir, "not-a-key\n", "import", "--passphrase", "secret")
+	require.Error(t, err)
+	out, err = executeEthKeysCmdWithInput(t, keyringDir, hexutil.Encode(crypto.FromECDSA(privateKey))+"\n", "import", "--passphrase", "secret", "--output", "json")
+	require.NoError(t, err)
+	var info EthereumKeyInfo
+	require.NoError(t, json.Unmarshal([]byte(out), &info))
+	require.Equal(t, ethAddr.Hex(), info.Address)
+
+	// list and show the key
+	out, err = executeEthKeysCmd(t, keyringDir, "list", "--output", "json")
+	require.NoError(t, err)
+	var infos []EthereumKeyInfo
+	require.NoError(t, json.Unmarshal([]byte(out), &infos))
+	require.Len(t, infos, 1)
+	require.Equal(t, ethAddr.Hex(), infos[0].Address)
+
+	out, err = executeEthKeysCmd(t, keyringDir, "show", ethAddr.Hex(), "--output", "json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(out), &info))
+	require.Equal(t, ethAddr.Hex(), info.Address)
+	require.FileExists(t, info.Path)
+
+	_, err = executeEthKeysCmd(t, keyringDir, "show", common.HexToAddress("0x01").Hex())
+	require.Error(t, err)
+
+	// the export is a keystore file encrypted with the export passphrase
+	out, err = executeEthKeysCmd(t, keyringDir, "export", ethAddr.Hex(), "--passphrase", "secret", "--export-passphrase", "exported")
+	require.NoError(t, err)
+	exported, err := keystore.DecryptKey([]byte(out), "exported")
+	require.NoError(t, err)
+	require.Equal(t, privateKey.D, exported.PrivateKey.D)
+
+	_, err = executeEthKeysCmd(t, keyringDir, "export", ethAddr.Hex(), "--passphrase", "wrong")
+	require.Error(t, err)
+
+	// delete the key and import it back from the exported keystore file
+	_, err = executeEthKeysCmd(t, keyringDir, "delete", ethAddr.Hex(), "--passphrase", "secret", "--yes")
+	require.NoError(t, err)
+	out, err = executeEthKeysCmd(t, keyringDir, "list")
+	require.NoError(t, err)
+	require.NotContains(t, out, ethAddr.Hex())
+
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	exportedJSON, err := keystore.EncryptKey(exported, "exported", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, exportedJSON, 0o600))
+
+	_, err = executeEthKeysCmd(t, keyringDir, "import", keyFile, "--import-passphrase", "exported", "--passphrase", "secret")
+	require.NoError(t, err)
+	out, err = executeEthKeysCmd(t, keyringDir, "list")
+	require.NoError(t, err)
+	require.Contains(t, out, ethAddr.Hex())
+
+	// sign the delegate keys with both signature versions
+	valAddr := sdk.ValAddress(bytes.Repeat([]byte{1}, 20))
+
+	out, err = executeEthKeysCmd(t, keyringDir, "sign-delegate-keys", ethAddr.Hex(), valAddr.String(), "7", "--passphrase", "secret")
+	require.NoError(t, err)
+	signature, err := hexutil.Decode(out)
+	require.NoError(t, err)
+	signMsgBz, err := (&types.DelegateKeysSignMsg{ValidatorAddress: valAddr.String(), Nonce: 7}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(crypto.Keccak256(signMsgBz), signature, ethAddr))
+
+	_, err = executeEthKeysCmd(t, keyringDir, "sign-delegate-keys", ethAddr.Hex(), valAddr.String(), "7", "--passphrase", "secret", "--signature-version", "1")
+	require.Error(t, err)
+
+	out, err = executeEthKeysCmd(t, keyringDir, "sign-delegate-keys", ethAddr.Hex(), valAddr.String(), "7", "--passphrase", "secret", "--signature-version", "1", "--bridge-chain-id", "11")
+	require.NoError(t, err)
+	signature, err = hexutil.Decode(out)
+	require.NoError(t, err)
+	hash, err := types.DelegateKeysTypedDataHash(valAddr.String(), 7, 11)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEIP712Signature(hash, signature, ethAddr))
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/relayer"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const (
+	flagEthereumRPC     = "ethereum-rpc"
+	flagGravityContract = "gravity-contract"
+	flagEVMChainID      = "evm-chain-id"
+	flagStartHeight     = "start-height"
+	flagFeePrices       = "fee-prices"
+	flagLoopInterval    = "loop-interval"
+)
+
+// RelayerCmd runs the relayer of the signed outgoing txs of the gravity module
+func RelayerCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer [ethereum-address]",
+		Short: "Relay the signed outgoing txs of the gravity module to the Gravity contract",
+		Long: fmt.Sprintf(`Relay the signer sets, batches and contract calls signed by the gravity module
+validators to the Gravity contract, paying the gas with a stored ethereum key.
+
+Signer sets are always relayed. Batches and contract calls are relayed when
+their fees are worth more than the gas they cost, at the wei prices of
+--fee-prices: 1000000000ugrav values 1ugrav at 1 gwei.
+
+Example:
+$ %s relayer 0x... --gravity-contract 0x... --start-height 1000 --fee-prices 1000000000ugrav --passphrase secret
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gravityContract, _ := cmd.Flags().GetString(flagGravityContract)
+			if !common.IsHexAddress(gravityContract) {
+				return fmt.Errorf("invalid gravity contract address %s", gravityContract)
+			}
+			feePrices, _ := cmd.Flags().GetString(flagFeePrices)
+			prices, err := sdk.ParseDecCoins(feePrices)
+			if err != nil {
+				return err
+			}
+
+			passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+			key, err := unlockKey(newKeyStore(clientCtx), args[0], passphrase)
+			if err != nil {
+				return err
+			}
+
+			rpc, _ := cmd.Flags().GetString(flagEthereumRPC)
+			eth, err := ethclient.DialContext(cmd.Context(), rpc)
+			if err != nil {
+				return err
+			}
+			defer eth.Close()
+			chainID, err := eth.ChainID(cmd.Context())
+			if err != nil {
+				return err
+			}
+			signer, err := bind.NewKeyedTransactorWithChainID(key.PrivateKey, chainID)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, _ := cmd.Flags().GetUint64(flagEVMChainID)
+			startHeight, _ := cmd.Flags().GetUint64(flagStartHeight)
+			r := relayer.NewRelayer(relayer.Config{
+				GravityContract: common.HexToAddress(gravityContract),
+				EVMChainID:      evmChainID,
+				StartHeight:     startHeight,
+				FeePrices:       prices,
+				Signer:          signer,
+			}, eth, types.NewQueryClient(clientCtx), server.GetServerContextFromCmd(cmd).Logger)
+
+			interval, _ := cmd.Flags().GetDuration(flagLoopInterval)
+			return r.Run(cmd.Context(), interval)
+		},
+	}
+
+	cmd.Flags().String(flagEthereumRPC, "http://localhost:8545", "The ethereum JSON-RPC endpoint")
+	cmd.Flags().String(flagGravityContract, "", "The address of the Gravity contract")
+	cmd.Flags().Uint64(flagEVMChainID, 0, "The bridge chain id of the EVM chain, 0 for the default chain")
+	cmd.Flags().Uint64(flagStartHeight, 0, "The height the Gravity contract was deployed at")
+	cmd.Flags().String(flagFeePrices, "", "The prices of the fee denoms, in wei per unit")
+	cmd.Flags().Duration(flagLoopInterval, 30*time.Second, "The interval between two relaying attempts")
+	cmd.Flags().String(flagPassphrase, "default", "Password used to decrypt the ethereum key on disk")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+// Package contracts holds the ABI of the Gravity.sol contract, shared by the
+// Go orchestrator and relayer. Gravity.json is a copy of
+// orchestrator/gravity_abi/Gravity.json and must be kept in sync with it.
+package contracts
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Names of the Gravity.sol events
+const (
+	SendToCosmosEventName             = "SendToCosmosEvent"
+	TransactionBatchExecutedEventName = "TransactionBatchExecutedEvent"
+	ERC20DeployedEventName            = "ERC20DeployedEvent"
+	LogicCallEventName                = "LogicCallEvent"
+	ValsetUpdatedEventName            = "ValsetUpdatedEvent"
+)
+
+// Names of the Gravity.sol methods called by the relayer
+const (
+	UpdateValsetMethodName    = "updateValset"
+	SubmitBatchMethodName     = "submitBatch"
+	SubmitLogicCallMethodName = "submitLogicCall"
+)
+
+//go:embed Gravity.json
+var gravityABIJSON string
+
+// GravityABI is the parsed ABI of the Gravity.sol contract
+var GravityABI abi.ABI
+
+func init() {
+	var err error
+	if GravityABI, err = abi.JSON(strings.NewReader(gravityABIJSON)); err != nil {
+		panic(err)
+	}
+}
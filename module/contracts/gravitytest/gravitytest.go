@@ -0,0 +1,216 @@
+// Package gravitytest deploys the compiled Gravity.sol contract on a simulated
+// ethereum backend for the tests of the Go orchestrator and relayer.
+package gravitytest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// ArtifactEnv names the environment variable overriding the path of the
+// hardhat artifact of Gravity.sol
+const ArtifactEnv = "GRAVITY_ARTIFACT"
+
+// CIEnv names the environment variable set by CI, where the tests fail
+// instead of skipping when the artifact of Gravity.sol isn't built
+const CIEnv = "CI"
+
+// ChainID is the chain id of the simulated backend
+const ChainID = 1337
+
+// ERC20ABI holds the methods of the cosmos originated ERC20s used by the tests
+var ERC20ABI abi.ABI
+
+func init() {
+	var err error
+	ERC20ABI, err = abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+	]`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ArtifactPath returns the path of the hardhat artifact of Gravity.sol, built
+// by `npx hardhat compile` in the solidity directory of the repository
+func ArtifactPath() string {
+	if path := os.Getenv(ArtifactEnv); path != "" {
+		return path
+	}
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "solidity", "artifacts", "contracts", "Gravity.sol", "Gravity.json")
+}
+
+// Bytecode returns the creation bytecode of the hardhat artifact of
+// Gravity.sol
+func Bytecode(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var artifact struct {
+		Bytecode string `json:"bytecode"`
+	}
+	if err := json.Unmarshal(bz, &artifact); err != nil {
+		return nil, err
+	}
+	return hexutil.Decode(artifact.Bytecode)
+}
+
+// Gravity is a Gravity.sol contract deployed on a simulated backend
+type Gravity struct {
+	t        *testing.T
+	Backend  *backends.SimulatedBackend
+	Address  common.Address
+	Contract *bind.BoundContract
+}
+
+// NewAccount returns the transactor of a new ethereum key
+func NewAccount(t *testing.T) *bind.TransactOpts {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(ChainID))
+	require.NoError(t, err)
+	return auth
+}
+
+// Power returns the given percentage of the normalized power of a signer set,
+// like the powers of the signer sets of the gravity module
+func Power(percent uint64) uint64 {
+	return percent * math.MaxUint32 / 100
+}
+
+// Deploy funds the accounts on a new simulated backend and deploys Gravity.sol
+// from the first one, with the members of the signer set in order. Like the
+// deployed bridge, the contract accepts the txs whose signatures hold more
+// than types.ContractPowerThreshold, so the signer set powers must be
+// normalized, see Power. The test is skipped when the artifact of
+// Gravity.sol isn't built, except in CI where it fails.
+func Deploy(t *testing.T, gravityID string, signerSet types.SignerSetTx, accounts ...*bind.TransactOpts) *Gravity {
+	bytecode, err := Bytecode(ArtifactPath())
+	if errors.Is(err, fs.ErrNotExist) && os.Getenv(CIEnv) == "" {
+		t.Skipf("the Gravity.sol artifact isn't built: %s", err)
+	}
+	require.NoError(t, err)
+
+	alloc := core.GenesisAlloc{}
+	for _, account := range accounts {
+		alloc[account.From] = core.GenesisAccount{Balance: big.NewInt(1e18)}
+	}
+	backend := backends.NewSimulatedBackend(alloc, 30_000_000)
+	t.Cleanup(func() { backend.Close() })
+
+	var id [32]byte
+	copy(id[:], gravityID)
+	validators := make([]common.Address, len(signerSet.Signers))
+	powers := make([]*big.Int, len(signerSet.Signers))
+	for i, signer := range signerSet.Signers {
+		validators[i] = common.HexToAddress(signer.EthereumAddress)
+		powers[i] = new(big.Int).SetUint64(signer.Power)
+	}
+	threshold := new(big.Int).SetUint64(types.ContractPowerThreshold)
+
+	address, _, contract, err := bind.DeployContract(accounts[0], contracts.GravityABI, bytecode, backend, id, threshold, validators, powers)
+	require.NoError(t, err)
+	backend.Commit()
+
+	return &Gravity{t: t, Backend: backend, Address: address, Contract: contract}
+}
+
+// Transact sends a tx calling the method of the contract, mined with the next
+// committed block
+func (g *Gravity) Transact(opts *bind.TransactOpts, method string, args ...interface{}) *gethtypes.Transaction {
+	tx, err := g.Contract.Transact(opts, method, args...)
+	require.NoError(g.t, err)
+	return tx
+}
+
+// RawTransact sends a tx with the calldata to the contract, mined with the
+// next committed block
+func (g *Gravity) RawTransact(opts *bind.TransactOpts, calldata []byte) *gethtypes.Transaction {
+	tx, err := g.Contract.RawTransact(opts, calldata)
+	require.NoError(g.t, err)
+	return tx
+}
+
+// Calls commits the pending txs and returns the calldata of the txs the
+// account sent to the contract, which must all have succeeded
+func (g *Gravity) Calls(from common.Address) [][]byte {
+	g.Backend.Commit()
+	ctx := context.Background()
+	signer := gethtypes.LatestSignerForChainID(big.NewInt(ChainID))
+
+	header, err := g.Backend.HeaderByNumber(ctx, nil)
+	require.NoError(g.t, err)
+
+	var calls [][]byte
+	for height := uint64(1); height <= header.Number.Uint64(); height++ {
+		block, err := g.Backend.BlockByNumber(ctx, new(big.Int).SetUint64(height))
+		require.NoError(g.t, err)
+
+		for _, tx := range block.Transactions() {
+			sender, err := gethtypes.Sender(signer, tx)
+			require.NoError(g.t, err)
+			if sender != from || tx.To() == nil || *tx.To() != g.Address {
+				continue
+			}
+
+			receipt, err := g.Backend.TransactionReceipt(ctx, tx.Hash())
+			require.NoError(g.t, err)
+			require.Equal(g.t, gethtypes.ReceiptStatusSuccessful, receipt.Status, "tx %s failed", tx.Hash())
+			calls = append(calls, tx.Data())
+		}
+	}
+	return calls
+}
+
+// DeployERC20 deploys a cosmos originated ERC20 holding its whole supply in
+// the contract, and returns its address
+func (g *Gravity) DeployERC20(opts *bind.TransactOpts, denom string) common.Address {
+	tx := g.Transact(opts, "deployERC20", denom, denom, denom, uint8(6))
+	g.Backend.Commit()
+
+	receipt, err := g.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(g.t, err)
+	require.Equal(g.t, gethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	ev := contracts.GravityABI.Events[contracts.ERC20DeployedEventName]
+	for _, log := range receipt.Logs {
+		if log.Topics[0] == ev.ID {
+			return common.BytesToAddress(log.Topics[1].Bytes())
+		}
+	}
+	require.FailNow(g.t, "no ERC20DeployedEvent")
+	return common.Address{}
+}
+
+// ApproveERC20 lets the contract spend the amount of the ERC20 of the account,
+// once the next block is committed
+func (g *Gravity) ApproveERC20(opts *bind.TransactOpts, token common.Address, amount *big.Int) {
+	_, err := bind.NewBoundContract(token, ERC20ABI, g.Backend, g.Backend, g.Backend).Transact(opts, "approve", g.Address, amount)
+	require.NoError(g.t, err)
+}
@@ -0,0 +1,94 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxBroadcaster is the Broadcaster signing the messages with the from key of
+// the client context. It tracks the account sequence itself, as the sequence
+// queried from the chain only moves once a tx is committed, so several txs
+// can be broadcast in one block. It is safe for concurrent use.
+type TxBroadcaster struct {
+	clientCtx client.Context
+
+	mu  sync.Mutex
+	txf tx.Factory
+}
+
+var _ Broadcaster = (*TxBroadcaster)(nil)
+
+// NewTxBroadcaster returns a broadcaster signing with the from key of the
+// client context and the fees and gas settings of the tx factory
+func NewTxBroadcaster(clientCtx client.Context, txf tx.Factory) *TxBroadcaster {
+	return &TxBroadcaster{clientCtx: clientCtx, txf: txf}
+}
+
+// BroadcastMsgs signs the messages in one tx and broadcasts it, returning an
+// error if the tx is rejected. The client context has no request context, so
+// ctx is only checked before building the tx.
+func (b *TxBroadcaster) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	clientCtx := b.clientCtx
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Prepare only queries the account sequence while the factory has none
+	txf, err := b.txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		// the tx may or may not have reached the mempool
+		b.resyncSequence()
+		return err
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			b.resyncSequence()
+		}
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	b.txf = txf.WithSequence(txf.Sequence() + 1)
+	return nil
+}
+
+// resyncSequence drops the tracked account sequence, so that the next tx
+// queries it from the chain again
+func (b *TxBroadcaster) resyncSequence() {
+	b.txf = b.txf.WithSequence(0)
+}
@@ -0,0 +1,147 @@
+package orchestrator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/orchestrator"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// mockChain accepts the txs whose signature has the next sequence of the
+// account in the mempool, like the ante handler in CheckTx. Queries return the
+// committed sequence, which only moves when a block is committed.
+type mockChain struct {
+	mock.Client
+	client.AccountRetriever
+	txConfig client.TxConfig
+
+	committedSequence uint64
+	mempoolSequence   uint64
+	txs               [][]sdk.Msg
+}
+
+func (m *mockChain) EnsureExists(client.Context, sdk.AccAddress) error {
+	return nil
+}
+
+func (m *mockChain) GetAccountNumberSequence(client.Context, sdk.AccAddress) (uint64, uint64, error) {
+	return 1, m.committedSequence, nil
+}
+
+func (m *mockChain) BroadcastTxSync(_ context.Context, txBytes cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	decoded, err := m.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := decoded.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if sigs[0].Sequence != m.mempoolSequence {
+		return &coretypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.RootCodespace,
+			Hash:      txBytes.Hash(),
+		}, nil
+	}
+
+	m.mempoolSequence++
+	m.txs = append(m.txs, decoded.GetMsgs())
+	return &coretypes.ResultBroadcastTx{Hash: txBytes.Hash()}, nil
+}
+
+// commit includes the txs of the mempool in a block
+func (m *mockChain) commit() {
+	m.committedSequence = m.mempoolSequence
+}
+
+func TestTxBroadcaster(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	kr := keyring.NewInMemory(cdc)
+	record, _, err := kr.NewMnemonic("orchestrator", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	orchestratorAddress, err := record.GetAddress()
+	require.NoError(t, err)
+
+	chain := &mockChain{txConfig: txConfig}
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithTxConfig(txConfig).
+		WithKeyring(kr).
+		WithFromName("orchestrator").
+		WithFromAddress(orchestratorAddress).
+		WithChainID("gravity-test").
+		WithBroadcastMode(flags.BroadcastSync).
+		WithClient(chain)
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithAccountRetriever(chain).
+		WithChainID("gravity-test").
+		WithGas(200000).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	broadcaster := orchestrator.NewTxBroadcaster(clientCtx, txf)
+
+	// more events than fit in one tx, all broadcast before the next block
+	msgs := make([]sdk.Msg, 2*orchestrator.DefaultMsgsPerTx+1)
+	for i := range msgs {
+		eventAny, err := types.PackEvent(&types.SendToCosmosEvent{
+			EventNonce:     uint64(i + 1),
+			TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+			Amount:         sdk.NewInt(100),
+			EthereumSender: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+			CosmosReceiver: orchestratorAddress.String(),
+		})
+		require.NoError(t, err)
+		msgs[i] = &types.MsgSubmitEthereumEvent{Event: eventAny, Signer: orchestratorAddress.String()}
+	}
+
+	ctx := context.Background()
+	for pending := msgs; len(pending) > 0; {
+		n := orchestrator.DefaultMsgsPerTx
+		if n > len(pending) {
+			n = len(pending)
+		}
+		require.NoError(t, broadcaster.BroadcastMsgs(ctx, pending[:n]...))
+		pending = pending[n:]
+	}
+	require.Len(t, chain.txs, 3)
+	require.Equal(t, uint64(3), chain.mempoolSequence)
+	chain.commit()
+
+	// another client signing with the key takes the next sequence, so the
+	// broadcaster queries the sequence again after the mismatch
+	chain.mempoolSequence++
+	chain.commit()
+	vote := types.NewMsgERC20MetadataVote(common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"), types.ERC20Metadata{Name: "ugrav", Symbol: "ugrav", Decimals: 6}, orchestratorAddress)
+	require.Error(t, broadcaster.BroadcastMsgs(ctx, vote))
+	require.NoError(t, broadcaster.BroadcastMsgs(ctx, vote))
+	require.Len(t, chain.txs, 4)
+	require.Equal(t, uint64(5), chain.mempoolSequence)
+}
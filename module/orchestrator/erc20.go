@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// erc20MetadataABI holds the optional metadata methods of the ERC20 standard
+var erc20MetadataABI abi.ABI
+
+func init() {
+	var err error
+	erc20MetadataABI, err = abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+		{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+		{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]}
+	]`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ERC20MetadataReader is the part of ethclient.Client the ERC20 metadata is
+// read with
+type ERC20MetadataReader interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// ReadERC20Metadata reads the name, symbol and decimals of the ERC20 at the
+// block. Reading them at the block of a deposit gives every orchestrator the
+// same metadata, even if the token changes it later. Tokens that don't
+// implement the methods, or return other types like bytes32 symbols, have no
+// metadata.
+func ReadERC20Metadata(ctx context.Context, eth ERC20MetadataReader, token common.Address, blockNumber *big.Int) (types.ERC20Metadata, error) {
+	call := func(method string) (interface{}, error) {
+		data, err := erc20MetadataABI.Pack(method)
+		if err != nil {
+			return nil, err
+		}
+		res, err := eth.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, blockNumber)
+		if err != nil {
+			return nil, fmt.Errorf("%s of %s: %w", method, token.Hex(), err)
+		}
+		out, err := erc20MetadataABI.Unpack(method, res)
+		if err != nil {
+			return nil, fmt.Errorf("%s of %s: %w", method, token.Hex(), err)
+		}
+		return out[0], nil
+	}
+
+	name, err := call("name")
+	if err != nil {
+		return types.ERC20Metadata{}, err
+	}
+	symbol, err := call("symbol")
+	if err != nil {
+		return types.ERC20Metadata{}, err
+	}
+	decimals, err := call("decimals")
+	if err != nil {
+		return types.ERC20Metadata{}, err
+	}
+
+	return types.ERC20Metadata{
+		Name:     name.(string),
+		Symbol:   symbol.(string),
+		Decimals: uint64(decimals.(uint8)),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package orchestrator_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/orchestrator"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// mockERC20 answers the metadata calls of an ERC20 with abi encoded results,
+// by method selector
+type mockERC20 struct {
+	results map[string][]byte
+	blocks  []*big.Int
+}
+
+func (m *mockERC20) CallContract(_ context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	m.blocks = append(m.blocks, blockNumber)
+	res, ok := m.results[string(call.Data[:4])]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	return res, nil
+}
+
+func TestReadERC20Metadata(t *testing.T) {
+	var (
+		stringType, _ = abi.NewType("string", "", nil)
+		uint8Type, _  = abi.NewType("uint8", "", nil)
+		bytes32, _    = abi.NewType("bytes32", "", nil)
+		token         = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		ctx           = context.Background()
+	)
+	pack := func(typ abi.Type, value interface{}) []byte {
+		bz, err := abi.Arguments{{Type: typ}}.Pack(value)
+		require.NoError(t, err)
+		return bz
+	}
+	selector := func(signature string) string {
+		return string(abi.NewMethod(signature, signature, abi.Function, "view", false, false, nil, nil).ID)
+	}
+
+	erc20 := &mockERC20{results: map[string][]byte{
+		selector("name"):     pack(stringType, "USD Coin"),
+		selector("symbol"):   pack(stringType, "USDC"),
+		selector("decimals"): pack(uint8Type, uint8(6)),
+	}}
+	metadata, err := orchestrator.ReadERC20Metadata(ctx, erc20, token, big.NewInt(42))
+	require.NoError(t, err)
+	require.Equal(t, types.ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}, metadata)
+	require.Equal(t, []*big.Int{big.NewInt(42), big.NewInt(42), big.NewInt(42)}, erc20.blocks)
+
+	// a bytes32 symbol isn't a string
+	var symbol [32]byte
+	copy(symbol[:], "MKR")
+	erc20.results[selector("symbol")] = pack(bytes32, symbol)
+	_, err = orchestrator.ReadERC20Metadata(ctx, erc20, token, big.NewInt(42))
+	require.Error(t, err)
+
+	// tokens without decimals have no metadata
+	erc20.results[selector("symbol")] = pack(stringType, "USDC")
+	delete(erc20.results, selector("decimals"))
+	_, err = orchestrator.ReadERC20Metadata(ctx, erc20, token, big.NewInt(42))
+	require.Error(t, err)
+}
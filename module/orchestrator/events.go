@@ -0,0 +1,259 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// ErrEventNonceGap is returned when an ethereum event nonce is missing from the
+// scanned logs, which requires rescanning the Gravity contract logs
+var ErrEventNonceGap = errors.New("gap in the ethereum event nonces")
+
+// invalidDenom replaces the denoms of ERC20 deployments that aren't valid sdk
+// denoms, so that the gravity module rejects them
+const invalidDenom = "invalid"
+
+// GravityEventIDs returns the topics of the Gravity.sol events the oracle observes
+func GravityEventIDs() []common.Hash {
+	return []common.Hash{
+		contracts.GravityABI.Events[contracts.SendToCosmosEventName].ID,
+		contracts.GravityABI.Events[contracts.TransactionBatchExecutedEventName].ID,
+		contracts.GravityABI.Events[contracts.ERC20DeployedEventName].ID,
+		contracts.GravityABI.Events[contracts.LogicCallEventName].ID,
+		contracts.GravityABI.Events[contracts.ValsetUpdatedEventName].ID,
+	}
+}
+
+type sendToCosmosLog struct {
+	TokenContract common.Address
+	Sender        common.Address
+	Destination   [32]byte
+	Amount        *big.Int
+	EventNonce    *big.Int
+}
+
+type transactionBatchExecutedLog struct {
+	BatchNonce *big.Int
+	Token      common.Address
+	EventNonce *big.Int
+}
+
+type erc20DeployedLog struct {
+	CosmosDenom   string
+	TokenContract common.Address
+	Name          string
+	Symbol        string
+	Decimals      uint8
+	EventNonce    *big.Int
+}
+
+type logicCallLog struct {
+	InvalidationId    [32]byte // named after the _invalidationId abi argument
+	InvalidationNonce *big.Int
+	ReturnData        []byte
+	EventNonce        *big.Int
+}
+
+type valsetUpdatedLog struct {
+	NewValsetNonce *big.Int
+	EventNonce     *big.Int
+	RewardAmount   *big.Int
+	RewardToken    common.Address
+	Validators     []common.Address
+	Powers         []*big.Int
+}
+
+// gravityContract unpacks the logs of any Gravity.sol contract
+var gravityContract = bind.NewBoundContract(common.Address{}, contracts.GravityABI, nil, nil, nil)
+
+// ParseEvent converts a Gravity.sol log into the ethereum event the gravity
+// module votes on
+func ParseEvent(log gethtypes.Log) (types.EthereumEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("log %s/%d has no topics", log.TxHash.Hex(), log.Index)
+	}
+
+	switch log.Topics[0] {
+	case contracts.GravityABI.Events[contracts.SendToCosmosEventName].ID:
+		var ev sendToCosmosLog
+		if err := gravityContract.UnpackLog(&ev, contracts.SendToCosmosEventName, log); err != nil {
+			return nil, err
+		}
+		nonce, err := uint64Value("event nonce", ev.EventNonce)
+		if err != nil {
+			return nil, err
+		}
+		return &types.SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  ev.TokenContract.Hex(),
+			Amount:         sdk.NewIntFromBigInt(ev.Amount),
+			EthereumSender: ev.Sender.Hex(),
+			// the destination is a cosmos address left padded to 32 bytes
+			CosmosReceiver: sdk.AccAddress(ev.Destination[12:]).String(),
+			EthereumHeight: log.BlockNumber,
+		}, nil
+
+	case contracts.GravityABI.Events[contracts.TransactionBatchExecutedEventName].ID:
+		var ev transactionBatchExecutedLog
+		if err := gravityContract.UnpackLog(&ev, contracts.TransactionBatchExecutedEventName, log); err != nil {
+			return nil, err
+		}
+		nonce, err := uint64Value("event nonce", ev.EventNonce)
+		if err != nil {
+			return nil, err
+		}
+		batchNonce, err := uint64Value("batch nonce", ev.BatchNonce)
+		if err != nil {
+			return nil, err
+		}
+		return &types.BatchExecutedEvent{
+			TokenContract:  ev.Token.Hex(),
+			EventNonce:     nonce,
+			EthereumHeight: log.BlockNumber,
+			BatchNonce:     batchNonce,
+		}, nil
+
+	case contracts.GravityABI.Events[contracts.ERC20DeployedEventName].ID:
+		var ev erc20DeployedLog
+		if err := gravityContract.UnpackLog(&ev, contracts.ERC20DeployedEventName, log); err != nil {
+			return nil, err
+		}
+		nonce, err := uint64Value("event nonce", ev.EventNonce)
+		if err != nil {
+			return nil, err
+		}
+		denom := ev.CosmosDenom
+		if sdk.ValidateDenom(denom) != nil {
+			denom = invalidDenom
+		}
+		return &types.ERC20DeployedEvent{
+			EventNonce:     nonce,
+			CosmosDenom:    denom,
+			TokenContract:  ev.TokenContract.Hex(),
+			Erc20Name:      ev.Name,
+			Erc20Symbol:    ev.Symbol,
+			Erc20Decimals:  uint64(ev.Decimals),
+			EthereumHeight: log.BlockNumber,
+		}, nil
+
+	case contracts.GravityABI.Events[contracts.LogicCallEventName].ID:
+		var ev logicCallLog
+		if err := gravityContract.UnpackLog(&ev, contracts.LogicCallEventName, log); err != nil {
+			return nil, err
+		}
+		nonce, err := uint64Value("event nonce", ev.EventNonce)
+		if err != nil {
+			return nil, err
+		}
+		invalidationNonce, err := uint64Value("invalidation nonce", ev.InvalidationNonce)
+		if err != nil {
+			return nil, err
+		}
+		return &types.ContractCallExecutedEvent{
+			EventNonce:        nonce,
+			InvalidationScope: ev.InvalidationId[:],
+			InvalidationNonce: invalidationNonce,
+			EthereumHeight:    log.BlockNumber,
+		}, nil
+
+	case contracts.GravityABI.Events[contracts.ValsetUpdatedEventName].ID:
+		var ev valsetUpdatedLog
+		if err := gravityContract.UnpackLog(&ev, contracts.ValsetUpdatedEventName, log); err != nil {
+			return nil, err
+		}
+		nonce, err := uint64Value("event nonce", ev.EventNonce)
+		if err != nil {
+			return nil, err
+		}
+		signerSetNonce, err := uint64Value("valset nonce", ev.NewValsetNonce)
+		if err != nil {
+			return nil, err
+		}
+		if len(ev.Validators) != len(ev.Powers) {
+			return nil, fmt.Errorf("valset %d has %d validators and %d powers", signerSetNonce, len(ev.Validators), len(ev.Powers))
+		}
+		members := make([]*types.EthereumSigner, len(ev.Validators))
+		for i, val := range ev.Validators {
+			power, err := uint64Value("power", ev.Powers[i])
+			if err != nil {
+				return nil, err
+			}
+			members[i] = &types.EthereumSigner{Power: power, EthereumAddress: val.Hex()}
+		}
+		// the reward amount and token of the event are not used by the gravity module
+		return &types.SignerSetTxExecutedEvent{
+			EventNonce:       nonce,
+			SignerSetTxNonce: signerSetNonce,
+			EthereumHeight:   log.BlockNumber,
+			Members:          members,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("log %s/%d is not a gravity event", log.TxHash.Hex(), log.Index)
+	}
+}
+
+// ParseEvents converts the Gravity.sol logs into ethereum events sorted by
+// event nonce. Logs removed by a reorg are skipped.
+func ParseEvents(logs []gethtypes.Log) ([]types.EthereumEvent, error) {
+	events := make([]types.EthereumEvent, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		event, err := ParseEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetEventNonce() < events[j].GetEventNonce()
+	})
+	return events, nil
+}
+
+// PendingEvents returns the events following the last submitted event nonce,
+// sorted by nonce and without duplicates. If an event nonce is missing it
+// returns the contiguous events before the gap along with ErrEventNonceGap.
+func PendingEvents(lastEventNonce uint64, events []types.EthereumEvent) ([]types.EthereumEvent, error) {
+	sorted := make([]types.EthereumEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetEventNonce() < sorted[j].GetEventNonce()
+	})
+
+	var pending []types.EthereumEvent
+	next := lastEventNonce + 1
+	for _, event := range sorted {
+		switch nonce := event.GetEventNonce(); {
+		case nonce < next:
+			// already submitted, or a duplicate of the previous event
+			continue
+		case nonce > next:
+			return pending, fmt.Errorf("%w: expected event nonce %d, found %d", ErrEventNonceGap, next, nonce)
+		}
+		pending = append(pending, event)
+		next++
+	}
+
+	return pending, nil
+}
+
+func uint64Value(name string, v *big.Int) (uint64, error) {
+	if v == nil || !v.IsUint64() {
+		return 0, fmt.Errorf("%s %s overflows uint64", name, v)
+	}
+	return v.Uint64(), nil
+}
@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestPendingEvents(t *testing.T) {
+	batch := func(nonce uint64) types.EthereumEvent {
+		return &types.BatchExecutedEvent{EventNonce: nonce}
+	}
+
+	specs := map[string]struct {
+		lastEventNonce uint64
+		events         []types.EthereumEvent
+		expNonces      []uint64
+		expGap         bool
+	}{
+		"contiguous": {
+			lastEventNonce: 2,
+			events:         []types.EthereumEvent{batch(3), batch(4), batch(5)},
+			expNonces:      []uint64{3, 4, 5},
+		},
+		"unsorted with submitted events and duplicates": {
+			lastEventNonce: 2,
+			events:         []types.EthereumEvent{batch(4), batch(1), batch(3), batch(2), batch(3)},
+			expNonces:      []uint64{3, 4},
+		},
+		"nothing pending": {
+			lastEventNonce: 5,
+			events:         []types.EthereumEvent{batch(4), batch(5)},
+		},
+		"gap after the last event nonce": {
+			lastEventNonce: 2,
+			events:         []types.EthereumEvent{batch(4), batch(5)},
+			expGap:         true,
+		},
+		"gap between events": {
+			lastEventNonce: 2,
+			events:         []types.EthereumEvent{batch(3), batch(5)},
+			expNonces:      []uint64{3},
+			expGap:         true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			pending, err := PendingEvents(spec.lastEventNonce, spec.events)
+			if spec.expGap {
+				require.ErrorIs(t, err, ErrEventNonceGap)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var nonces []uint64
+			for _, event := range pending {
+				nonces = append(nonces, event.GetEventNonce())
+			}
+			require.Equal(t, spec.expNonces, nonces)
+		})
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	ev := contracts.GravityABI.Events[contracts.ERC20DeployedEventName]
+	token := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	data, err := ev.Inputs.NonIndexed().Pack("not a denom!", "Gravity", "GRAV", uint8(6), big.NewInt(2))
+	require.NoError(t, err)
+
+	// invalid denoms are replaced so that the gravity module rejects them
+	event, err := ParseEvent(gethtypes.Log{
+		Topics:      []common.Hash{ev.ID, common.BytesToHash(token.Bytes())},
+		Data:        data,
+		BlockNumber: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, invalidDenom, event.(*types.ERC20DeployedEvent).CosmosDenom)
+	require.Equal(t, uint64(10), event.GetEthereumHeight())
+
+	_, err = ParseEvent(gethtypes.Log{Topics: []common.Hash{common.HexToHash("0x01")}})
+	require.Error(t, err)
+
+	_, err = ParseEvent(gethtypes.Log{})
+	require.Error(t, err)
+
+	// the event nonce must fit a uint64
+	data, err = ev.Inputs.NonIndexed().Pack("ugrav", "Gravity", "GRAV", uint8(6), new(big.Int).Lsh(big.NewInt(1), 64))
+	require.NoError(t, err)
+	_, err = ParseEvent(gethtypes.Log{
+		Topics: []common.Hash{ev.ID, common.BytesToHash(token.Bytes())},
+		Data:   data,
+	})
+	require.Error(t, err)
+
+	// removed logs are skipped
+	events, err := ParseEvents([]gethtypes.Log{{Removed: true}})
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
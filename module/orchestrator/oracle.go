@@ -0,0 +1,349 @@
+// Package orchestrator observes the Gravity.sol contract and submits its events
+// to the gravity module, like the oracle of the Rust orchestrator.
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const (
+	// DefaultBlocksToSearch is the default number of ethereum blocks scanned at once
+	DefaultBlocksToSearch = 5000
+	// DefaultMsgsPerTx is the default number of events submitted in one cosmos tx
+	DefaultMsgsPerTx = 50
+)
+
+// EthereumClient is the part of ethclient.Client the oracle reads the Gravity
+// contract logs and the metadata of the deposited ERC20s with. go-ethereum's
+// simulated backend implements it too.
+type EthereumClient interface {
+	ERC20MetadataReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethtypes.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethtypes.Log, error)
+}
+
+// Broadcaster signs and broadcasts the messages of the orchestrator in one
+// cosmos tx
+type Broadcaster interface {
+	BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// OracleConfig configures the Oracle
+type OracleConfig struct {
+	// GravityContract is the address of the Gravity.sol contract
+	GravityContract common.Address
+	// Orchestrator is the orchestrator account submitting the events
+	Orchestrator sdk.AccAddress
+	// EVMChainID is the bridge chain id of the EVM chain, 0 for the default chain
+	EVMChainID uint64
+	// StartHeight is the height the Gravity contract was deployed at, the
+	// lowest block scanned for events
+	StartHeight uint64
+	// BlockDelay is the number of confirmations an event needs to be submitted
+	BlockDelay uint64
+	// BlocksToSearch is the number of blocks scanned at once
+	BlocksToSearch uint64
+	// MsgsPerTx is the number of events submitted in one cosmos tx
+	MsgsPerTx int
+}
+
+// Oracle submits the events of the Gravity contract to the gravity module in
+// event nonce order
+type Oracle struct {
+	config      OracleConfig
+	eth         EthereumClient
+	queryClient types.QueryClient
+	broadcaster Broadcaster
+	logger      log.Logger
+
+	// synced is set once the oracle found the block of the last event nonce
+	// submitted by the orchestrator, and reset when an event nonce is missing
+	synced           bool
+	lastCheckedBlock uint64
+	// metadataVoted holds the tokens whose ERC20 metadata the oracle voted on
+	metadataVoted map[common.Address]bool
+}
+
+// NewOracle returns an oracle observing the Gravity contract with the ethereum
+// client and submitting its events with the broadcaster
+func NewOracle(config OracleConfig, eth EthereumClient, queryClient types.QueryClient, broadcaster Broadcaster, logger log.Logger) *Oracle {
+	if config.BlocksToSearch == 0 {
+		config.BlocksToSearch = DefaultBlocksToSearch
+	}
+	if config.MsgsPerTx <= 0 {
+		config.MsgsPerTx = DefaultMsgsPerTx
+	}
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+
+	return &Oracle{
+		config:        config,
+		eth:           eth,
+		queryClient:   queryClient,
+		broadcaster:   broadcaster,
+		logger:        logger.With("module", "oracle", "evm_chain_id", config.EVMChainID),
+		metadataVoted: make(map[common.Address]bool),
+	}
+}
+
+// LastCheckedBlock returns the last ethereum block scanned for events
+func (o *Oracle) LastCheckedBlock() uint64 {
+	return o.lastCheckedBlock
+}
+
+// Run steps the oracle every interval until the context is done
+func (o *Oracle) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := o.Step(ctx); err != nil {
+			o.logger.Error("oracle step failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step scans the next range of confirmed blocks and submits the events
+// following the last event nonce submitted by the orchestrator. The scan
+// starts again from the last checked block, so events of a block that was
+// only partially submitted aren't lost. When an event nonce is missing, the
+// events before the gap are submitted and the next step resyncs.
+func (o *Oracle) Step(ctx context.Context) error {
+	if !o.synced {
+		if err := o.Resync(ctx); err != nil {
+			return err
+		}
+	}
+
+	latest, err := o.confirmedHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	from := o.lastCheckedBlock
+	to := from + o.config.BlocksToSearch
+	if to > latest {
+		to = latest
+	}
+	if to < from {
+		return nil
+	}
+
+	events, err := o.scan(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	lastEventNonce, err := o.lastEventNonce(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending, gapErr := PendingEvents(lastEventNonce, events)
+	if err := o.submit(ctx, pending); err != nil {
+		return err
+	}
+	if err := o.voteERC20Metadata(ctx, pending); err != nil {
+		return err
+	}
+
+	if gapErr != nil {
+		// the missing event is before the scanned range, or its log was not
+		// returned by the ethereum node
+		o.synced = false
+		return gapErr
+	}
+
+	o.lastCheckedBlock = to
+	return nil
+}
+
+// Resync finds the block of the last event nonce submitted by the
+// orchestrator, scanning the Gravity contract logs backwards from the latest
+// confirmed block, and continues scanning from it
+func (o *Oracle) Resync(ctx context.Context) error {
+	lastEventNonce, err := o.lastEventNonce(ctx)
+	if err != nil {
+		return err
+	}
+
+	if lastEventNonce == 0 {
+		o.lastCheckedBlock, o.synced = o.config.StartHeight, true
+		o.logger.Info("oracle resynced", "last_event_nonce", lastEventNonce, "height", o.lastCheckedBlock)
+		return nil
+	}
+
+	latest, err := o.confirmedHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	for end := latest; end >= o.config.StartHeight; {
+		start := o.config.StartHeight
+		if end > start+o.config.BlocksToSearch {
+			start = end - o.config.BlocksToSearch
+		}
+
+		events, err := o.scan(ctx, start, end)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if event.GetEventNonce() == lastEventNonce {
+				o.lastCheckedBlock, o.synced = event.GetEthereumHeight(), true
+				o.logger.Info("oracle resynced", "last_event_nonce", lastEventNonce, "height", o.lastCheckedBlock)
+				return nil
+			}
+		}
+
+		if start == o.config.StartHeight {
+			break
+		}
+		end = start - 1
+	}
+
+	return fmt.Errorf("event nonce %d not found in the gravity contract logs since height %d", lastEventNonce, o.config.StartHeight)
+}
+
+// confirmedHeight returns the height of the latest block with enough
+// confirmations
+func (o *Oracle) confirmedHeight(ctx context.Context) (uint64, error) {
+	header, err := o.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	height := header.Number.Uint64()
+	if height < o.config.BlockDelay {
+		return 0, nil
+	}
+	return height - o.config.BlockDelay, nil
+}
+
+// scan returns the events of the Gravity contract in the blocks [from, to]
+func (o *Oracle) scan(ctx context.Context, from, to uint64) ([]types.EthereumEvent, error) {
+	logs, err := o.eth.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{o.config.GravityContract},
+		Topics:    [][]common.Hash{GravityEventIDs()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseEvents(logs)
+}
+
+// lastEventNonce returns the last event nonce submitted by the orchestrator
+func (o *Oracle) lastEventNonce(ctx context.Context) (uint64, error) {
+	res, err := o.queryClient.LastSubmittedEthereumEvent(ctx, &types.LastSubmittedEthereumEventRequest{
+		Address:    o.config.Orchestrator.String(),
+		EvmChainId: o.config.EVMChainID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.EventNonce, nil
+}
+
+// submit broadcasts the events in batches of MsgSubmitEthereumEvent
+func (o *Oracle) submit(ctx context.Context, events []types.EthereumEvent) error {
+	msgs := make([]sdk.Msg, 0, len(events))
+	for _, event := range events {
+		eventAny, err := types.PackEvent(event)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, &types.MsgSubmitEthereumEvent{
+			Event:      eventAny,
+			Signer:     o.config.Orchestrator.String(),
+			EvmChainId: o.config.EVMChainID,
+		})
+		o.logger.Info("oracle observed event", "type", fmt.Sprintf("%T", event), "event_nonce", event.GetEventNonce(), "height", event.GetEthereumHeight())
+	}
+
+	for len(msgs) > 0 {
+		n := o.config.MsgsPerTx
+		if n > len(msgs) {
+			n = len(msgs)
+		}
+		if err := o.broadcaster.BroadcastMsgs(ctx, msgs[:n]...); err != nil {
+			return err
+		}
+		msgs = msgs[n:]
+	}
+
+	return nil
+}
+
+// voteERC20Metadata votes on the metadata of the Ethereum-originated tokens
+// deposited by the events, read at the block of the deposit. The oracle votes
+// once per token, and skips the tokens whose metadata can't be read.
+func (o *Oracle) voteERC20Metadata(ctx context.Context, events []types.EthereumEvent) error {
+	var (
+		msgs   []sdk.Msg
+		tokens = make(map[common.Address]bool)
+	)
+	for _, event := range events {
+		deposit, ok := event.(*types.SendToCosmosEvent)
+		if !ok {
+			continue
+		}
+		token := common.HexToAddress(deposit.TokenContract)
+		if o.metadataVoted[token] || tokens[token] {
+			continue
+		}
+
+		res, err := o.queryClient.ERC20ToDenom(ctx, &types.ERC20ToDenomRequest{
+			Erc20:      token.Hex(),
+			EvmChainId: o.config.EVMChainID,
+		})
+		if err != nil {
+			return err
+		}
+		tokens[token] = true
+		if res.CosmosOriginated {
+			continue
+		}
+
+		metadata, err := ReadERC20Metadata(ctx, o.eth, token, new(big.Int).SetUint64(deposit.EthereumHeight))
+		if err != nil {
+			o.logger.Info("skipping erc20 metadata vote", "token_contract", token.Hex(), "err", err)
+			continue
+		}
+		msg := types.NewMsgERC20MetadataVote(token, metadata, o.config.Orchestrator)
+		msg.EvmChainId = o.config.EVMChainID
+		msgs = append(msgs, msg)
+		o.logger.Info("oracle observed erc20 metadata", "token_contract", token.Hex(), "symbol", metadata.Symbol)
+	}
+
+	if len(msgs) > 0 {
+		if err := o.broadcaster.BroadcastMsgs(ctx, msgs...); err != nil {
+			return err
+		}
+	}
+	for token := range tokens {
+		o.metadataVoted[token] = true
+	}
+	return nil
+}
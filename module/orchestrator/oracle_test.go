@@ -0,0 +1,303 @@
+package orchestrator_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts/gravitytest"
+	"github.com/peggyjv/gravity-bridge/module/v6/orchestrator"
+	"github.com/peggyjv/gravity-bridge/module/v6/relayer"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const gravityID = "gravity-test"
+
+type mockQueryClient struct {
+	types.QueryClient
+	lastEventNonce   uint64
+	cosmosOriginated map[string]bool
+}
+
+func (m *mockQueryClient) LastSubmittedEthereumEvent(_ context.Context, _ *types.LastSubmittedEthereumEventRequest, _ ...grpc.CallOption) (*types.LastSubmittedEthereumEventResponse, error) {
+	return &types.LastSubmittedEthereumEventResponse{EventNonce: m.lastEventNonce}, nil
+}
+
+func (m *mockQueryClient) ERC20ToDenom(_ context.Context, req *types.ERC20ToDenomRequest, _ ...grpc.CallOption) (*types.ERC20ToDenomResponse, error) {
+	return &types.ERC20ToDenomResponse{CosmosOriginated: m.cosmosOriginated[req.Erc20]}, nil
+}
+
+// mockBroadcaster records the submitted events and metadata votes, and moves
+// the event nonce of the orchestrator forward like the gravity module
+type mockBroadcaster struct {
+	queryClient   *mockQueryClient
+	txs           int
+	events        []types.EthereumEvent
+	metadataVotes []*types.MsgERC20MetadataVote
+}
+
+func (m *mockBroadcaster) BroadcastMsgs(_ context.Context, msgs ...sdk.Msg) error {
+	m.txs++
+	for _, msg := range msgs {
+		if vote, ok := msg.(*types.MsgERC20MetadataVote); ok {
+			m.metadataVotes = append(m.metadataVotes, vote)
+			continue
+		}
+		event, err := types.UnpackEvent(msg.(*types.MsgSubmitEthereumEvent).Event)
+		if err != nil {
+			return err
+		}
+		if event.GetEventNonce() == m.queryClient.lastEventNonce+1 {
+			m.queryClient.lastEventNonce++
+		}
+		m.events = append(m.events, event)
+	}
+	return nil
+}
+
+// newSignerSet returns a signer set of new ethereum keys with the powers, in
+// order, and the keys of its members
+func newSignerSet(t *testing.T, nonce uint64, powers ...uint64) (types.SignerSetTx, []*ecdsa.PrivateKey) {
+	signerSet := types.SignerSetTx{Nonce: nonce}
+	keys := make([]*ecdsa.PrivateKey, len(powers))
+	for i, power := range powers {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		signerSet.Signers = append(signerSet.Signers, &types.EthereumSigner{
+			Power:           power,
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	return signerSet, keys
+}
+
+// signatures returns the signatures of the outgoing tx by all the members of
+// the current signer set
+func signatures(t *testing.T, current types.SignerSetTx, keys []*ecdsa.PrivateKey, otx types.OutgoingTx) []relayer.ValSignature {
+	checkpoint := otx.GetCheckpoint([]byte(gravityID))
+	confirmations := make([]types.EthereumTxConfirmation, len(keys))
+	for i, key := range keys {
+		signature, err := types.NewEthereumSignature(checkpoint, key)
+		require.NoError(t, err)
+		confirmations[i] = &types.SignerSetTxConfirmation{EthereumSigner: crypto.PubkeyToAddress(key.PublicKey).Hex(), Signature: signature}
+	}
+
+	sigs, err := relayer.OrderSignatures(current, checkpoint, confirmations)
+	require.NoError(t, err)
+	return sigs
+}
+
+// submitBatch sends the batch signed by the current signer set
+func submitBatch(t *testing.T, gravity *gravitytest.Gravity, opts *bind.TransactOpts, current types.SignerSetTx, keys []*ecdsa.PrivateKey, batch types.BatchTx) {
+	calldata, err := relayer.SubmitBatchCalldata(current, signatures(t, current, keys, &batch), batch)
+	require.NoError(t, err)
+	gravity.RawTransact(opts, calldata)
+}
+
+func TestOracle(t *testing.T) {
+	current, keys := newSignerSet(t, 0, gravitytest.Power(50), gravitytest.Power(30), gravitytest.Power(20))
+	deployer, sender := gravitytest.NewAccount(t), gravitytest.NewAccount(t)
+	gravity := gravitytest.Deploy(t, gravityID, current, deployer, sender)
+
+	queryClient := &mockQueryClient{}
+	broadcaster := &mockBroadcaster{queryClient: queryClient}
+	orchestratorAddress := sdk.AccAddress(common.HexToAddress("0x01").Bytes())
+	oracle := orchestrator.NewOracle(orchestrator.OracleConfig{
+		GravityContract: gravity.Address,
+		Orchestrator:    orchestratorAddress,
+		MsgsPerTx:       2,
+	}, gravity.Backend, queryClient, broadcaster, nil)
+
+	var (
+		receiver = sdk.AccAddress(common.HexToAddress("0x02").Bytes())
+		scope    = common.HexToHash("0x04")
+	)
+
+	token := gravity.DeployERC20(deployer, "ugrav")
+	amount := func(amount int64) types.ERC20Token {
+		return types.ERC20Token{Contract: token.Hex(), Amount: sdk.NewInt(amount)}
+	}
+
+	// two events in one block: a batch sending tokens to the sender, and a
+	// signer set update
+	submitBatch(t, gravity, deployer, current, keys, types.BatchTx{
+		BatchNonce: 8,
+		Timeout:    1000,
+		Transactions: []*types.SendToEthereum{{
+			Id:                1,
+			EthereumRecipient: sender.From.Hex(),
+			Erc20Token:        amount(1000),
+			Erc20Fee:          amount(0),
+		}},
+		TokenContract: token.Hex(),
+	})
+	next := types.SignerSetTx{Nonce: 7, Signers: current.Signers}
+	calldata, err := relayer.UpdateValsetCalldata(next, current, signatures(t, current, keys, &next))
+	require.NoError(t, err)
+	gravity.RawTransact(deployer, calldata)
+	gravity.Backend.Commit()
+
+	gravity.ApproveERC20(sender, token, big.NewInt(1000))
+	gravity.Transact(sender, "sendToCosmos", token, [32]byte(common.BytesToHash(receiver)), big.NewInt(1000))
+	gravity.Backend.Commit()
+
+	// the ERC20 stands in for the logic contract
+	payload, err := gravitytest.ERC20ABI.Pack("balanceOf", sender.From)
+	require.NoError(t, err)
+	call := types.ContractCallTx{
+		InvalidationScope: scope.Bytes(),
+		InvalidationNonce: 9,
+		Address:           token.Hex(),
+		Payload:           payload,
+		Timeout:           1000,
+	}
+	calldata, err = relayer.SubmitLogicCallCalldata(next, signatures(t, next, keys, &call), call)
+	require.NoError(t, err)
+	gravity.RawTransact(deployer, calldata)
+	gravity.Backend.Commit()
+
+	ctx := context.Background()
+	require.NoError(t, oracle.Step(ctx))
+	require.Equal(t, uint64(6), queryClient.lastEventNonce)
+	require.Equal(t, 4, broadcaster.txs)
+	require.Len(t, broadcaster.events, 6)
+
+	// the mock chain doesn't record the deployment, so the oracle votes on
+	// the metadata of the deposited token, read from its contract
+	require.Equal(t, []*types.MsgERC20MetadataVote{
+		types.NewMsgERC20MetadataVote(token, types.ERC20Metadata{Name: "ugrav", Symbol: "ugrav", Decimals: 6}, orchestratorAddress),
+	}, broadcaster.metadataVotes)
+
+	// the contract emits its first signer set when it is deployed
+	require.Equal(t, &types.SignerSetTxExecutedEvent{
+		EventNonce:       1,
+		SignerSetTxNonce: 0,
+		EthereumHeight:   1,
+		Members:          current.Signers,
+	}, broadcaster.events[0])
+	require.Equal(t, &types.ERC20DeployedEvent{
+		EventNonce:     2,
+		CosmosDenom:    "ugrav",
+		TokenContract:  token.Hex(),
+		Erc20Name:      "ugrav",
+		Erc20Symbol:    "ugrav",
+		Erc20Decimals:  6,
+		EthereumHeight: 2,
+	}, broadcaster.events[1])
+	require.Equal(t, &types.BatchExecutedEvent{
+		TokenContract:  token.Hex(),
+		EventNonce:     3,
+		EthereumHeight: 3,
+		BatchNonce:     8,
+	}, broadcaster.events[2])
+	require.Equal(t, &types.SignerSetTxExecutedEvent{
+		EventNonce:       4,
+		SignerSetTxNonce: 7,
+		EthereumHeight:   3,
+		Members:          next.Signers,
+	}, broadcaster.events[3])
+	require.Equal(t, &types.SendToCosmosEvent{
+		EventNonce:     5,
+		TokenContract:  token.Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: sender.From.Hex(),
+		CosmosReceiver: receiver.String(),
+		EthereumHeight: 4,
+	}, broadcaster.events[4])
+	require.Equal(t, &types.ContractCallExecutedEvent{
+		EventNonce:        6,
+		InvalidationScope: scope.Bytes(),
+		InvalidationNonce: 9,
+		EthereumHeight:    5,
+	}, broadcaster.events[5])
+	for _, event := range broadcaster.events {
+		require.NoError(t, event.Validate())
+	}
+
+	// nothing new to submit
+	require.NoError(t, oracle.Step(ctx))
+	require.Len(t, broadcaster.events, 6)
+	require.Equal(t, uint64(5), oracle.LastCheckedBlock())
+
+	// the chain lost the last events, so the next event leaves a gap until the
+	// oracle rescans from the block of the last event it has
+	queryClient.lastEventNonce = 2
+	submitBatch(t, gravity, deployer, next, keys, types.BatchTx{
+		BatchNonce: 10,
+		Timeout:    1000,
+		Transactions: []*types.SendToEthereum{{
+			Id:                2,
+			EthereumRecipient: sender.From.Hex(),
+			Erc20Token:        amount(1),
+			Erc20Fee:          amount(0),
+		}},
+		TokenContract: token.Hex(),
+	})
+	gravity.Backend.Commit()
+
+	require.ErrorIs(t, oracle.Step(ctx), orchestrator.ErrEventNonceGap)
+	require.Len(t, broadcaster.events, 6)
+
+	require.NoError(t, oracle.Step(ctx))
+	require.Equal(t, uint64(7), queryClient.lastEventNonce)
+	require.Len(t, broadcaster.events, 11)
+	for i, event := range broadcaster.events[6:] {
+		require.Equal(t, uint64(3+i), event.GetEventNonce())
+	}
+	require.Equal(t, uint64(6), oracle.LastCheckedBlock())
+
+	// the metadata of a token is voted on once
+	require.Len(t, broadcaster.metadataVotes, 1)
+}
+
+func TestOracleResyncBlockDelay(t *testing.T) {
+	current, _ := newSignerSet(t, 0, gravitytest.Power(100))
+	deployer := gravitytest.NewAccount(t)
+	gravity := gravitytest.Deploy(t, gravityID, current, deployer)
+
+	queryClient := &mockQueryClient{}
+	broadcaster := &mockBroadcaster{queryClient: queryClient}
+	oracle := orchestrator.NewOracle(orchestrator.OracleConfig{
+		GravityContract: gravity.Address,
+		BlockDelay:      2,
+		BlocksToSearch:  1,
+	}, gravity.Backend, queryClient, broadcaster, nil)
+
+	// one ERC20 deployment per block, after the signer set of the contract
+	for _, denom := range []string{"uatom", "ugrav", "ustake"} {
+		gravity.DeployERC20(deployer, denom)
+	}
+
+	// the orchestrator submitted the first deployment before restarting
+	queryClient.lastEventNonce = 2
+	ctx := context.Background()
+	require.NoError(t, oracle.Resync(ctx))
+	require.Equal(t, uint64(2), oracle.LastCheckedBlock())
+
+	// the events of blocks 3 and 4 don't have enough confirmations yet
+	require.NoError(t, oracle.Step(ctx))
+	require.Equal(t, uint64(2), queryClient.lastEventNonce)
+	require.Empty(t, broadcaster.events)
+
+	gravity.Backend.Commit()
+	require.NoError(t, oracle.Step(ctx))
+	require.Equal(t, uint64(3), queryClient.lastEventNonce)
+
+	gravity.Backend.Commit()
+	require.NoError(t, oracle.Step(ctx))
+	require.Equal(t, uint64(4), queryClient.lastEventNonce)
+	require.Equal(t, uint64(4), oracle.LastCheckedBlock())
+
+	// the resync fails if the last event nonce is not in the logs
+	queryClient.lastEventNonce = 10
+	require.Error(t, oracle.Resync(ctx))
+}
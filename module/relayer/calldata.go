@@ -0,0 +1,153 @@
+package relayer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// ErrInsufficientPower is returned when the valid signatures of an outgoing tx
+// don't hold more than the power threshold of the Gravity contract
+var ErrInsufficientPower = errors.New("insufficient signer set power")
+
+// ValsetArgs is the ValsetArgs struct of Gravity.sol
+type ValsetArgs struct {
+	Validators   []common.Address
+	Powers       []*big.Int
+	ValsetNonce  *big.Int
+	RewardAmount *big.Int
+	RewardToken  common.Address
+}
+
+// ValSignature is the ValSignature struct of Gravity.sol. A zero v marks a
+// signer that didn't sign, which the contract skips.
+type ValSignature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// LogicCallArgs is the LogicCallArgs struct of Gravity.sol
+type LogicCallArgs struct {
+	TransferAmounts        []*big.Int
+	TransferTokenContracts []common.Address
+	FeeAmounts             []*big.Int
+	FeeTokenContracts      []common.Address
+	LogicContractAddress   common.Address
+	Payload                []byte
+	TimeOut                *big.Int
+	InvalidationId         [32]byte // named after the invalidationId abi component
+	InvalidationNonce      *big.Int
+}
+
+// NewValsetArgs returns the contract arguments of the signer set, with its
+// members in order. The module doesn't pay signer set rewards, like the
+// checkpoints it signs.
+func NewValsetArgs(signerSet types.SignerSetTx) ValsetArgs {
+	args := ValsetArgs{
+		Validators:   make([]common.Address, len(signerSet.Signers)),
+		Powers:       make([]*big.Int, len(signerSet.Signers)),
+		ValsetNonce:  new(big.Int).SetUint64(signerSet.Nonce),
+		RewardAmount: big.NewInt(0),
+	}
+	for i, signer := range signerSet.Signers {
+		args.Validators[i] = common.HexToAddress(signer.EthereumAddress)
+		args.Powers[i] = new(big.Int).SetUint64(signer.Power)
+	}
+	return args
+}
+
+// OrderSignatures returns the signatures of the checkpoint in the order of the
+// members of the current signer set. Members without a valid confirmation get
+// an empty signature. It returns ErrInsufficientPower unless the members with
+// valid signatures hold more than types.ContractPowerThreshold, the power the
+// Gravity contract requires.
+func OrderSignatures(current types.SignerSetTx, checkpoint []byte, confirmations []types.EthereumTxConfirmation) ([]ValSignature, error) {
+	signatures := make(map[common.Address][]byte, len(confirmations))
+	for _, confirmation := range confirmations {
+		signatures[confirmation.GetSigner()] = confirmation.GetSignature()
+	}
+
+	sigs := make([]ValSignature, len(current.Signers))
+	var signedPower uint64
+	for i, signer := range current.Signers {
+		address := common.HexToAddress(signer.EthereumAddress)
+		signature, ok := signatures[address]
+		if !ok || types.ValidateEthereumSignature(checkpoint, signature, address) != nil {
+			continue
+		}
+
+		copy(sigs[i].R[:], signature[:32])
+		copy(sigs[i].S[:], signature[32:64])
+		sigs[i].V = signature[64]
+		if sigs[i].V < 27 {
+			sigs[i].V += 27
+		}
+		signedPower += signer.Power
+	}
+
+	if signedPower <= types.ContractPowerThreshold {
+		return nil, fmt.Errorf("%w: signatures hold %d, the contract requires more than %d", ErrInsufficientPower, signedPower, types.ContractPowerThreshold)
+	}
+	return sigs, nil
+}
+
+// UpdateValsetCalldata returns the calldata of updateValset, moving the
+// contract from the current signer set to the new one
+func UpdateValsetCalldata(newSignerSet, current types.SignerSetTx, sigs []ValSignature) ([]byte, error) {
+	return contracts.GravityABI.Pack(contracts.UpdateValsetMethodName, NewValsetArgs(newSignerSet), NewValsetArgs(current), sigs)
+}
+
+// SubmitBatchCalldata returns the calldata of submitBatch
+func SubmitBatchCalldata(current types.SignerSetTx, sigs []ValSignature, batch types.BatchTx) ([]byte, error) {
+	amounts := make([]*big.Int, len(batch.Transactions))
+	destinations := make([]common.Address, len(batch.Transactions))
+	fees := make([]*big.Int, len(batch.Transactions))
+	for i, tx := range batch.Transactions {
+		amounts[i] = tx.Erc20Token.Amount.BigInt()
+		destinations[i] = common.HexToAddress(tx.EthereumRecipient)
+		fees[i] = tx.Erc20Fee.Amount.BigInt()
+	}
+
+	return contracts.GravityABI.Pack(
+		contracts.SubmitBatchMethodName,
+		NewValsetArgs(current),
+		sigs,
+		amounts,
+		destinations,
+		fees,
+		new(big.Int).SetUint64(batch.BatchNonce),
+		common.HexToAddress(batch.TokenContract),
+		new(big.Int).SetUint64(batch.Timeout),
+	)
+}
+
+// SubmitLogicCallCalldata returns the calldata of submitLogicCall
+func SubmitLogicCallCalldata(current types.SignerSetTx, sigs []ValSignature, call types.ContractCallTx) ([]byte, error) {
+	args := LogicCallArgs{
+		TransferAmounts:        make([]*big.Int, len(call.Tokens)),
+		TransferTokenContracts: make([]common.Address, len(call.Tokens)),
+		FeeAmounts:             make([]*big.Int, len(call.Fees)),
+		FeeTokenContracts:      make([]common.Address, len(call.Fees)),
+		LogicContractAddress:   common.HexToAddress(call.Address),
+		Payload:                call.Payload,
+		TimeOut:                new(big.Int).SetUint64(call.Timeout),
+		InvalidationNonce:      new(big.Int).SetUint64(call.InvalidationNonce),
+	}
+	for i, token := range call.Tokens {
+		args.TransferAmounts[i] = token.Amount.BigInt()
+		args.TransferTokenContracts[i] = common.HexToAddress(token.Contract)
+	}
+	for i, fee := range call.Fees {
+		args.FeeAmounts[i] = fee.Amount.BigInt()
+		args.FeeTokenContracts[i] = common.HexToAddress(fee.Contract)
+	}
+	copy(args.InvalidationId[:], call.InvalidationScope)
+
+	return contracts.GravityABI.Pack(contracts.SubmitLogicCallMethodName, NewValsetArgs(current), sigs, args)
+}
@@ -0,0 +1,493 @@
+// Package relayer submits the outgoing txs signed by the gravity module
+// validators to the Gravity.sol contract, like the relayer of the Rust
+// orchestrator.
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/orchestrator"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+const (
+	// DefaultBlocksToSearch is the default number of ethereum blocks scanned
+	// at once for the current signer set
+	DefaultBlocksToSearch = 5000
+	// DefaultPendingTimeout is the default number of ethereum blocks a sent tx
+	// may stay unmined
+	DefaultPendingTimeout = 50
+)
+
+// Config configures the Relayer
+type Config struct {
+	// GravityContract is the address of the Gravity.sol contract
+	GravityContract common.Address
+	// EVMChainID is the bridge chain id of the EVM chain, 0 for the default chain
+	EVMChainID uint64
+	// StartHeight is the height the Gravity contract was deployed at, the
+	// lowest block scanned for the current signer set
+	StartHeight uint64
+	// BlocksToSearch is the number of blocks scanned at once
+	BlocksToSearch uint64
+	// PendingTimeout is the number of blocks a sent ethereum tx may stay
+	// unmined. The relayer then assumes it was dropped, like an underpriced
+	// tx, and may relay its outgoing tx again.
+	PendingTimeout uint64
+	// FeePrices values the fees of batches and contract calls, in wei per
+	// unit of each denom. Fees in other denoms are worth nothing.
+	FeePrices sdk.DecCoins
+	// Signer signs the ethereum txs of the relayer
+	Signer *bind.TransactOpts
+}
+
+// EthereumClient reads the Gravity contract and sends the relayed txs to it.
+// ethclient.Client and go-ethereum's simulated backend both implement it.
+type EthereumClient interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*gethtypes.Receipt, error)
+}
+
+// Relayer submits the signer sets, batches and contract calls of the gravity
+// module to the Gravity contract once they have enough signatures
+type Relayer struct {
+	config      Config
+	eth         EthereumClient
+	queryClient types.QueryClient
+	logger      log.Logger
+	contract    *bind.BoundContract
+
+	gravityID string
+	// pending holds the sent ethereum txs that aren't mined yet, by the store
+	// index of the outgoing tx they relay
+	pending map[string]pendingTx
+}
+
+// pendingTx is a sent ethereum tx
+type pendingTx struct {
+	hash common.Hash
+	// height is the latest ethereum block when the tx was sent
+	height uint64
+}
+
+// NewRelayer returns a relayer reading the outgoing txs with the query client
+// and sending them to the Gravity contract with the ethereum client
+func NewRelayer(config Config, eth EthereumClient, queryClient types.QueryClient, logger log.Logger) *Relayer {
+	if config.BlocksToSearch == 0 {
+		config.BlocksToSearch = DefaultBlocksToSearch
+	}
+	if config.PendingTimeout == 0 {
+		config.PendingTimeout = DefaultPendingTimeout
+	}
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+
+	return &Relayer{
+		config:      config,
+		eth:         eth,
+		queryClient: queryClient,
+		logger:      logger.With("module", "relayer", "evm_chain_id", config.EVMChainID),
+		contract:    bind.NewBoundContract(config.GravityContract, contracts.GravityABI, eth, eth, eth),
+		pending:     map[string]pendingTx{},
+	}
+}
+
+// Run steps the relayer every interval until the context is done
+func (r *Relayer) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Step(ctx); err != nil {
+			r.logger.Error("relayer step failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step relays the latest signer set signed by the current signer set of the
+// Gravity contract. If there is none, it relays the profitable batches and
+// contract calls signed by the current signer set, oldest first. The outgoing
+// txs relayed by a sent ethereum tx are skipped until it is mined or times out.
+func (r *Relayer) Step(ctx context.Context) error {
+	if r.config.Signer == nil {
+		return errors.New("relayer has no ethereum signer")
+	}
+
+	if r.gravityID == "" {
+		res, err := r.queryClient.Params(ctx, &types.ParamsRequest{EvmChainId: r.config.EVMChainID})
+		if err != nil {
+			return err
+		}
+		r.gravityID = res.Params.GravityId
+	}
+
+	header, err := r.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	height := header.Number.Uint64()
+
+	if err := r.prunePending(ctx, height); err != nil {
+		return err
+	}
+
+	current, err := r.CurrentSignerSet(ctx)
+	if err != nil {
+		return err
+	}
+
+	relayed, err := r.relaySignerSet(ctx, current, height)
+	if err != nil || relayed {
+		// the batches and contract calls are signed by the new signer set
+		return err
+	}
+
+	if err := r.relayBatches(ctx, current, height); err != nil {
+		return err
+	}
+	return r.relayContractCalls(ctx, current, height)
+}
+
+// CurrentSignerSet returns the signer set of the Gravity contract, with its
+// members in the order of its last ValsetUpdatedEvent. The logs are scanned
+// backwards from the latest block.
+func (r *Relayer) CurrentSignerSet(ctx context.Context) (types.SignerSetTx, error) {
+	header, err := r.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return types.SignerSetTx{}, err
+	}
+
+	for end := header.Number.Uint64(); end >= r.config.StartHeight; {
+		start := r.config.StartHeight
+		if end > start+r.config.BlocksToSearch {
+			start = end - r.config.BlocksToSearch
+		}
+
+		logs, err := r.eth.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{r.config.GravityContract},
+			Topics:    [][]common.Hash{{contracts.GravityABI.Events[contracts.ValsetUpdatedEventName].ID}},
+		})
+		if err != nil {
+			return types.SignerSetTx{}, err
+		}
+		events, err := orchestrator.ParseEvents(logs)
+		if err != nil {
+			return types.SignerSetTx{}, err
+		}
+		if len(events) > 0 {
+			event := events[len(events)-1].(*types.SignerSetTxExecutedEvent)
+			return types.SignerSetTx{Nonce: event.SignerSetTxNonce, Signers: event.Members}, nil
+		}
+
+		if start == r.config.StartHeight {
+			break
+		}
+		end = start - 1
+	}
+
+	return types.SignerSetTx{}, fmt.Errorf("no signer set found in the gravity contract logs since height %d", r.config.StartHeight)
+}
+
+// relaySignerSet relays the latest signer set following the current one that
+// has enough signatures, and reports whether it did
+func (r *Relayer) relaySignerSet(ctx context.Context, current types.SignerSetTx, height uint64) (bool, error) {
+	res, err := r.queryClient.SignerSetTxs(ctx, &types.SignerSetTxsRequest{EvmChainId: r.config.EVMChainID})
+	if err != nil {
+		return false, err
+	}
+	signerSets := res.SignerSets
+	sort.Slice(signerSets, func(i, j int) bool { return signerSets[i].Nonce > signerSets[j].Nonce })
+
+	for _, signerSet := range signerSets {
+		if signerSet.Nonce <= current.Nonce {
+			break
+		}
+
+		if _, ok := r.pending[string(signerSet.GetStoreIndex())]; ok {
+			// the batches and contract calls wait for the update too
+			r.logger.Debug("signer set update is pending", "nonce", signerSet.Nonce)
+			return true, nil
+		}
+
+		confirmations, err := r.queryClient.SignerSetTxConfirmations(ctx, &types.SignerSetTxConfirmationsRequest{
+			SignerSetNonce: signerSet.Nonce,
+			EvmChainId:     r.config.EVMChainID,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		// the members are signed and submitted in sorted order
+		signerSet.Signers.Sort()
+		sigs, err := orderSignatures(current, signerSet.GetCheckpoint([]byte(r.gravityID)), confirmations.Signatures)
+		if err != nil {
+			r.logger.Debug("signer set can't be relayed", "nonce", signerSet.Nonce, "err", err)
+			continue
+		}
+		data, err := UpdateValsetCalldata(*signerSet, current, sigs)
+		if err != nil {
+			return false, err
+		}
+
+		// signer set updates pay no fees, but relaying them unblocks the
+		// batches and contract calls signed by the new signer set
+		gas, gasPrice, err := r.estimate(ctx, data)
+		if err != nil {
+			r.logger.Info("signer set update would fail", "nonce", signerSet.Nonce, "err", err)
+			continue
+		}
+		if err := r.send(ctx, signerSet, data, gas, gasPrice, height); err != nil {
+			return false, err
+		}
+		r.logger.Info("relayed signer set", "nonce", signerSet.Nonce)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// relayBatches relays the profitable batches that haven't timed out, oldest
+// first. The fees of all the batches are valued first, so that the batches
+// aren't estimated when no fee is worth anything.
+func (r *Relayer) relayBatches(ctx context.Context, current types.SignerSetTx, height uint64) error {
+	fees, err := r.queryClient.BatchTxFees(ctx, &types.BatchTxFeesRequest{EvmChainId: r.config.EVMChainID})
+	if err != nil {
+		return err
+	}
+	if r.value(fees.Fees).Sign() == 0 {
+		return nil
+	}
+
+	res, err := r.queryClient.BatchTxs(ctx, &types.BatchTxsRequest{EvmChainId: r.config.EVMChainID})
+	if err != nil {
+		return err
+	}
+	batches := res.Batches
+	sort.Slice(batches, func(i, j int) bool { return batches[i].BatchNonce < batches[j].BatchNonce })
+
+	for _, batch := range batches {
+		if batch.Timeout <= height {
+			continue
+		}
+		if _, ok := r.pending[string(batch.GetStoreIndex())]; ok {
+			continue
+		}
+
+		confirmations, err := r.queryClient.BatchTxConfirmations(ctx, &types.BatchTxConfirmationsRequest{
+			BatchNonce:    batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			EvmChainId:    r.config.EVMChainID,
+		})
+		if err != nil {
+			return err
+		}
+		sigs, err := orderSignatures(current, batch.GetCheckpoint([]byte(r.gravityID)), confirmations.Signatures)
+		if err != nil {
+			r.logger.Debug("batch can't be relayed", "token_contract", batch.TokenContract, "nonce", batch.BatchNonce, "err", err)
+			continue
+		}
+		data, err := SubmitBatchCalldata(current, sigs, *batch)
+		if err != nil {
+			return err
+		}
+
+		tokens := make([]types.ERC20Token, len(batch.Transactions))
+		for i, tx := range batch.Transactions {
+			tokens[i] = tx.Erc20Fee
+		}
+		if err := r.relayIfProfitable(ctx, batch, data, tokens, height, "batch", "token_contract", batch.TokenContract, "nonce", batch.BatchNonce); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// relayContractCalls relays the profitable contract calls that haven't timed
+// out, oldest first
+func (r *Relayer) relayContractCalls(ctx context.Context, current types.SignerSetTx, height uint64) error {
+	res, err := r.queryClient.ContractCallTxs(ctx, &types.ContractCallTxsRequest{EvmChainId: r.config.EVMChainID})
+	if err != nil {
+		return err
+	}
+	calls := res.Calls
+	sort.Slice(calls, func(i, j int) bool { return calls[i].InvalidationNonce < calls[j].InvalidationNonce })
+
+	for _, call := range calls {
+		if call.Timeout <= height {
+			continue
+		}
+		if _, ok := r.pending[string(call.GetStoreIndex())]; ok {
+			continue
+		}
+
+		confirmations, err := r.queryClient.ContractCallTxConfirmations(ctx, &types.ContractCallTxConfirmationsRequest{
+			InvalidationScope: call.InvalidationScope,
+			InvalidationNonce: call.InvalidationNonce,
+			EvmChainId:        r.config.EVMChainID,
+		})
+		if err != nil {
+			return err
+		}
+		sigs, err := orderSignatures(current, call.GetCheckpoint([]byte(r.gravityID)), confirmations.Signatures)
+		if err != nil {
+			r.logger.Debug("contract call can't be relayed", "invalidation_nonce", call.InvalidationNonce, "err", err)
+			continue
+		}
+		data, err := SubmitLogicCallCalldata(current, sigs, *call)
+		if err != nil {
+			return err
+		}
+
+		if err := r.relayIfProfitable(ctx, call, data, call.Fees, height, "contract call", "invalidation_nonce", call.InvalidationNonce); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// relayIfProfitable sends the calldata if the fees are worth more than the gas
+// it costs
+func (r *Relayer) relayIfProfitable(ctx context.Context, otx types.OutgoingTx, data []byte, fees []types.ERC20Token, height uint64, kind string, keyvals ...interface{}) error {
+	gas, gasPrice, err := r.estimate(ctx, data)
+	if err != nil {
+		r.logger.Info(kind+" would fail", append(keyvals, "err", err)...)
+		return nil
+	}
+
+	coins, err := r.feeCoins(ctx, fees)
+	if err != nil {
+		return err
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	if value := r.value(coins); value.Cmp(cost) < 0 {
+		r.logger.Debug(kind+" is not profitable", append(keyvals, "fees", coins, "value", value, "cost", cost)...)
+		return nil
+	}
+
+	if err := r.send(ctx, otx, data, gas, gasPrice, height); err != nil {
+		return err
+	}
+	r.logger.Info("relayed "+kind, keyvals...)
+	return nil
+}
+
+// orderSignatures returns the signatures of the confirmations in the order of
+// the current signer set
+func orderSignatures[C types.EthereumTxConfirmation](current types.SignerSetTx, checkpoint []byte, confirmations []C) ([]ValSignature, error) {
+	confs := make([]types.EthereumTxConfirmation, len(confirmations))
+	for i, confirmation := range confirmations {
+		confs[i] = confirmation
+	}
+	return OrderSignatures(current, checkpoint, confs)
+}
+
+// feeCoins returns the fees in the denoms of the gravity module
+func (r *Relayer) feeCoins(ctx context.Context, fees []types.ERC20Token) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, fee := range fees {
+		res, err := r.queryClient.ERC20ToDenom(ctx, &types.ERC20ToDenomRequest{
+			Erc20:      fee.Contract,
+			EvmChainId: r.config.EVMChainID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(sdk.NewCoin(res.Denom, fee.Amount))
+	}
+	return coins, nil
+}
+
+// value returns the value of the coins in wei, at the configured fee prices
+func (r *Relayer) value(coins sdk.Coins) *big.Int {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		value = value.Add(r.config.FeePrices.AmountOf(coin.Denom).MulInt(coin.Amount))
+	}
+	return value.TruncateInt().BigInt()
+}
+
+// estimate returns the gas and gas price of sending the calldata to the
+// Gravity contract. It fails if the contract call reverts.
+func (r *Relayer) estimate(ctx context.Context, data []byte) (uint64, *big.Int, error) {
+	gas, err := r.eth.EstimateGas(ctx, ethereum.CallMsg{
+		From: r.config.Signer.From,
+		To:   &r.config.GravityContract,
+		Data: data,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	gasPrice, err := r.eth.SuggestGasPrice(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return gas, gasPrice, nil
+}
+
+// prunePending forgets the sent ethereum txs that are mined, or that are still
+// unmined after the pending timeout. A successful tx makes the contract reject
+// the next attempts to relay its outgoing tx, while the outgoing tx of a
+// failed or dropped one may be relayed again.
+func (r *Relayer) prunePending(ctx context.Context, height uint64) error {
+	for storeIndex, tx := range r.pending {
+		receipt, err := r.eth.TransactionReceipt(ctx, tx.hash)
+		if errors.Is(err, ethereum.NotFound) {
+			if height >= tx.height+r.config.PendingTimeout {
+				delete(r.pending, storeIndex)
+				r.logger.Info("relayed ethereum tx timed out", "hash", tx.hash.Hex(), "height", tx.height)
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		delete(r.pending, storeIndex)
+		if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+			r.logger.Info("relayed ethereum tx failed", "hash", tx.hash.Hex())
+		}
+	}
+	return nil
+}
+
+// send signs and sends an ethereum tx with the calldata to the Gravity
+// contract at the latest block height, pending until it is mined or times out
+func (r *Relayer) send(ctx context.Context, otx types.OutgoingTx, data []byte, gas uint64, gasPrice *big.Int, height uint64) error {
+	opts := *r.config.Signer
+	opts.Context = ctx
+	opts.GasLimit = gas
+	opts.GasPrice = gasPrice
+
+	tx, err := r.contract.RawTransact(&opts, data)
+	if err != nil {
+		return err
+	}
+	r.pending[string(otx.GetStoreIndex())] = pendingTx{hash: tx.Hash(), height: height}
+	r.logger.Debug("sent ethereum tx", "hash", tx.Hash().Hex())
+	return nil
+}
@@ -0,0 +1,345 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts/gravitytest"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// calls returns the method and arguments of the calls the account relayed to
+// the contract
+func calls(t *testing.T, gravity *gravitytest.Gravity, from common.Address) (methods []string, args [][]interface{}) {
+	for _, calldata := range gravity.Calls(from) {
+		method, err := contracts.GravityABI.MethodById(calldata[:4])
+		require.NoError(t, err)
+		values, err := method.Inputs.Unpack(calldata[4:])
+		require.NoError(t, err)
+		methods = append(methods, method.Name)
+		args = append(args, values)
+	}
+	return methods, args
+}
+
+type mockQueryClient struct {
+	types.QueryClient
+
+	signerSets                []*types.SignerSetTx
+	signerSetConfirmations    map[uint64][]*types.SignerSetTxConfirmation
+	batches                   []*types.BatchTx
+	batchConfirmations        map[uint64][]*types.BatchTxConfirmation
+	calls                     []*types.ContractCallTx
+	contractCallConfirmations map[uint64][]*types.ContractCallTxConfirmation
+}
+
+func (m *mockQueryClient) Params(_ context.Context, _ *types.ParamsRequest, _ ...grpc.CallOption) (*types.ParamsResponse, error) {
+	return &types.ParamsResponse{Params: types.Params{GravityId: "gravity-test"}}, nil
+}
+
+func (m *mockQueryClient) SignerSetTxs(_ context.Context, _ *types.SignerSetTxsRequest, _ ...grpc.CallOption) (*types.SignerSetTxsResponse, error) {
+	return &types.SignerSetTxsResponse{SignerSets: m.signerSets}, nil
+}
+
+func (m *mockQueryClient) SignerSetTxConfirmations(_ context.Context, req *types.SignerSetTxConfirmationsRequest, _ ...grpc.CallOption) (*types.SignerSetTxConfirmationsResponse, error) {
+	return &types.SignerSetTxConfirmationsResponse{Signatures: m.signerSetConfirmations[req.SignerSetNonce]}, nil
+}
+
+func (m *mockQueryClient) BatchTxs(_ context.Context, _ *types.BatchTxsRequest, _ ...grpc.CallOption) (*types.BatchTxsResponse, error) {
+	return &types.BatchTxsResponse{Batches: m.batches}, nil
+}
+
+func (m *mockQueryClient) BatchTxConfirmations(_ context.Context, req *types.BatchTxConfirmationsRequest, _ ...grpc.CallOption) (*types.BatchTxConfirmationsResponse, error) {
+	return &types.BatchTxConfirmationsResponse{Signatures: m.batchConfirmations[req.BatchNonce]}, nil
+}
+
+func (m *mockQueryClient) ContractCallTxs(_ context.Context, _ *types.ContractCallTxsRequest, _ ...grpc.CallOption) (*types.ContractCallTxsResponse, error) {
+	return &types.ContractCallTxsResponse{Calls: m.calls}, nil
+}
+
+func (m *mockQueryClient) ContractCallTxConfirmations(_ context.Context, req *types.ContractCallTxConfirmationsRequest, _ ...grpc.CallOption) (*types.ContractCallTxConfirmationsResponse, error) {
+	return &types.ContractCallTxConfirmationsResponse{Signatures: m.contractCallConfirmations[req.InvalidationNonce]}, nil
+}
+
+func (m *mockQueryClient) BatchTxFees(_ context.Context, _ *types.BatchTxFeesRequest, _ ...grpc.CallOption) (*types.BatchTxFeesResponse, error) {
+	res := &types.BatchTxFeesResponse{}
+	for _, batch := range m.batches {
+		for _, tx := range batch.Transactions {
+			res.Fees = append(res.Fees, sdk.NewCoin("ugrav", tx.Erc20Fee.Amount))
+		}
+	}
+	return res, nil
+}
+
+func (m *mockQueryClient) ERC20ToDenom(_ context.Context, _ *types.ERC20ToDenomRequest, _ ...grpc.CallOption) (*types.ERC20ToDenomResponse, error) {
+	return &types.ERC20ToDenomResponse{Denom: "ugrav", CosmosOriginated: true}, nil
+}
+
+func sign(t *testing.T, checkpoint []byte, key *ecdsa.PrivateKey) []byte {
+	signature, err := types.NewEthereumSignature(checkpoint, key)
+	require.NoError(t, err)
+	return signature
+}
+
+func TestOrderSignatures(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	current := types.SignerSetTx{Nonce: 1}
+	for i, percent := range []uint64{20, 50, 30} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		current.Signers = append(current.Signers, &types.EthereumSigner{
+			Power:           gravitytest.Power(percent),
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	checkpoint := crypto.Keccak256([]byte("checkpoint"))
+	confirmation := func(i int, signature []byte) types.EthereumTxConfirmation {
+		return &types.SignerSetTxConfirmation{EthereumSigner: current.Signers[i].EthereumAddress, Signature: signature}
+	}
+
+	// 50% doesn't pass the contract threshold
+	_, err := OrderSignatures(current, checkpoint, []types.EthereumTxConfirmation{confirmation(1, sign(t, checkpoint, keys[1]))})
+	require.ErrorIs(t, err, ErrInsufficientPower)
+
+	// neither does 50 + 30 with the signature of a member over another checkpoint
+	_, err = OrderSignatures(current, checkpoint, []types.EthereumTxConfirmation{
+		confirmation(1, sign(t, checkpoint, keys[1])),
+		confirmation(2, sign(t, crypto.Keccak256([]byte("other")), keys[2])),
+	})
+	require.ErrorIs(t, err, ErrInsufficientPower)
+
+	sigs, err := OrderSignatures(current, checkpoint, []types.EthereumTxConfirmation{
+		confirmation(2, sign(t, checkpoint, keys[2])),
+		confirmation(1, sign(t, checkpoint, keys[1])),
+	})
+	require.NoError(t, err)
+	require.Len(t, sigs, 3)
+	require.Equal(t, ValSignature{}, sigs[0])
+	for i, sig := range sigs[1:] {
+		require.Contains(t, []uint8{27, 28}, sig.V)
+		signature := append(append(sig.R[:], sig.S[:]...), sig.V)
+		require.NoError(t, types.ValidateEthereumSignature(checkpoint, signature, common.HexToAddress(current.Signers[i+1].EthereumAddress)))
+	}
+
+	// the threshold is the one of the contract, which is below two thirds
+	current.Signers[1].Power = types.ContractPowerThreshold - current.Signers[2].Power
+	_, err = OrderSignatures(current, checkpoint, []types.EthereumTxConfirmation{
+		confirmation(1, sign(t, checkpoint, keys[1])),
+		confirmation(2, sign(t, checkpoint, keys[2])),
+	})
+	require.ErrorIs(t, err, ErrInsufficientPower)
+	current.Signers[1].Power++
+	_, err = OrderSignatures(current, checkpoint, []types.EthereumTxConfirmation{
+		confirmation(1, sign(t, checkpoint, keys[1])),
+		confirmation(2, sign(t, checkpoint, keys[2])),
+	})
+	require.NoError(t, err)
+}
+
+// mockEthereumClient holds sent txs that are never mined
+type mockEthereumClient struct {
+	EthereumClient
+}
+
+func (m *mockEthereumClient) TransactionReceipt(context.Context, common.Hash) (*gethtypes.Receipt, error) {
+	return nil, ethereum.NotFound
+}
+
+func TestPrunePending(t *testing.T) {
+	relayer := NewRelayer(Config{PendingTimeout: 10}, &mockEthereumClient{}, &mockQueryClient{}, nil)
+	dropped := common.HexToHash("0x01")
+	relayer.pending["batch"] = pendingTx{hash: dropped, height: 100}
+
+	ctx := context.Background()
+	require.NoError(t, relayer.prunePending(ctx, 109))
+	require.Equal(t, map[string]pendingTx{"batch": {hash: dropped, height: 100}}, relayer.pending)
+
+	// the tx was dropped, so the batch may be relayed again
+	require.NoError(t, relayer.prunePending(ctx, 110))
+	require.Empty(t, relayer.pending)
+}
+
+func TestRelayer(t *testing.T) {
+	queryClient := &mockQueryClient{}
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	current := types.SignerSetTx{}
+	for i, percent := range []uint64{50, 30, 20} {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		current.Signers = append(current.Signers, &types.EthereumSigner{
+			Power:           gravitytest.Power(percent),
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	deployer, relayerAccount := gravitytest.NewAccount(t), gravitytest.NewAccount(t)
+	gravity := gravitytest.Deploy(t, "gravity-test", current, deployer, relayerAccount)
+	token := gravity.DeployERC20(deployer, "ugrav")
+
+	relayer := NewRelayer(Config{
+		GravityContract: gravity.Address,
+		FeePrices:       sdk.NewDecCoins(sdk.NewDecCoin("ugrav", sdk.NewInt(1e15))),
+		Signer:          relayerAccount,
+	}, gravity.Backend, queryClient, nil)
+
+	ctx := context.Background()
+	found, err := relayer.CurrentSignerSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, current, found)
+
+	gravityID := []byte("gravity-test")
+	fee := func(amount int64) types.ERC20Token {
+		return types.ERC20Token{Contract: token.Hex(), Amount: sdk.NewInt(amount)}
+	}
+
+	// the next signer set is relayed once it is signed by more than the
+	// contract threshold of the power of the current signer set, whatever the order of its members
+	next := &types.SignerSetTx{Nonce: 2, Signers: types.EthereumSigners{current.Signers[2], current.Signers[0], current.Signers[1]}}
+	queryClient.signerSets = []*types.SignerSetTx{next}
+	checkpoint := next.GetCheckpoint(gravityID)
+	queryClient.signerSetConfirmations = map[uint64][]*types.SignerSetTxConfirmation{2: {
+		{SignerSetNonce: 2, EthereumSigner: current.Signers[0].EthereumAddress, Signature: sign(t, checkpoint, keys[0])},
+	}}
+
+	require.NoError(t, relayer.Step(ctx))
+	methods, _ := calls(t, gravity, relayerAccount.From)
+	require.Empty(t, methods)
+
+	queryClient.signerSetConfirmations[2] = append(queryClient.signerSetConfirmations[2], &types.SignerSetTxConfirmation{
+		SignerSetNonce: 2, EthereumSigner: current.Signers[2].EthereumAddress, Signature: sign(t, checkpoint, keys[2]),
+	})
+	require.NoError(t, relayer.Step(ctx))
+	// the update isn't sent again until it is mined
+	require.NoError(t, relayer.Step(ctx))
+	methods, args := calls(t, gravity, relayerAccount.From)
+	require.Equal(t, []string{contracts.UpdateValsetMethodName}, methods)
+
+	newValset := *abi.ConvertType(args[0][0], new(ValsetArgs)).(*ValsetArgs)
+	require.Equal(t, big.NewInt(2), newValset.ValsetNonce)
+	require.Equal(t, NewValsetArgs(*next).Powers, newValset.Powers)
+	currentValset := *abi.ConvertType(args[0][1], new(ValsetArgs)).(*ValsetArgs)
+	require.Equal(t, NewValsetArgs(current).Validators, currentValset.Validators)
+	require.Zero(t, currentValset.ValsetNonce.Sign())
+	sigs := *abi.ConvertType(args[0][2], new([]ValSignature)).(*[]ValSignature)
+	require.Len(t, sigs, 3)
+	require.NotZero(t, sigs[0].V)
+	require.Zero(t, sigs[1].V)
+	require.NotZero(t, sigs[2].V)
+
+	// the batches and contract calls are signed by the new signer set once
+	// the contract emits the update
+	queryClient.signerSets = nil
+	current, err = relayer.CurrentSignerSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), current.Nonce)
+
+	signed := func(checkpoint []byte, signers ...int) (addresses []string, signatures [][]byte) {
+		for _, i := range signers {
+			addresses = append(addresses, crypto.PubkeyToAddress(keys[i].PublicKey).Hex())
+			signatures = append(signatures, sign(t, checkpoint, keys[i]))
+		}
+		return addresses, signatures
+	}
+
+	batch := &types.BatchTx{
+		BatchNonce: 3,
+		Timeout:    1000,
+		Transactions: []*types.SendToEthereum{{
+			Id:                1,
+			EthereumRecipient: common.HexToAddress("0x01").Hex(),
+			Erc20Token:        fee(1000),
+			Erc20Fee:          fee(100),
+		}},
+		TokenContract: token.Hex(),
+	}
+	// no fee to pay for the gas
+	unprofitable := &types.BatchTx{
+		BatchNonce: 4,
+		Timeout:    1000,
+		Transactions: []*types.SendToEthereum{{
+			Id:                2,
+			EthereumRecipient: common.HexToAddress("0x01").Hex(),
+			Erc20Token:        fee(1000),
+			Erc20Fee:          fee(0),
+		}},
+		TokenContract: token.Hex(),
+	}
+	timedOut := &types.BatchTx{BatchNonce: 5, Timeout: 1, TokenContract: token.Hex()}
+	queryClient.batches = []*types.BatchTx{timedOut, unprofitable, batch}
+	queryClient.batchConfirmations = map[uint64][]*types.BatchTxConfirmation{}
+	for _, b := range queryClient.batches {
+		addresses, signatures := signed(b.GetCheckpoint(gravityID), 0, 1, 2)
+		for i := range addresses {
+			queryClient.batchConfirmations[b.BatchNonce] = append(queryClient.batchConfirmations[b.BatchNonce], &types.BatchTxConfirmation{
+				TokenContract: b.TokenContract, BatchNonce: b.BatchNonce, EthereumSigner: addresses[i], Signature: signatures[i],
+			})
+		}
+	}
+
+	// the ERC20 stands in for the logic contract
+	payload, err := gravitytest.ERC20ABI.Pack("balanceOf", common.HexToAddress("0x01"))
+	require.NoError(t, err)
+	call := &types.ContractCallTx{
+		InvalidationNonce: 1,
+		InvalidationScope: []byte("scope"),
+		Address:           token.Hex(),
+		Payload:           payload,
+		Timeout:           1000,
+		Tokens:            []types.ERC20Token{fee(10)},
+		Fees:              []types.ERC20Token{fee(100)},
+	}
+	queryClient.calls = []*types.ContractCallTx{call}
+	// 50% doesn't pass the contract threshold
+	addresses, signatures := signed(call.GetCheckpoint(gravityID), 0)
+	queryClient.contractCallConfirmations = map[uint64][]*types.ContractCallTxConfirmation{1: {{
+		InvalidationScope: call.InvalidationScope, InvalidationNonce: 1, EthereumSigner: addresses[0], Signature: signatures[0],
+	}}}
+
+	require.NoError(t, relayer.Step(ctx))
+	require.NoError(t, relayer.Step(ctx))
+	methods, args = calls(t, gravity, relayerAccount.From)
+	require.Equal(t, []string{contracts.UpdateValsetMethodName, contracts.SubmitBatchMethodName}, methods)
+	require.Equal(t, big.NewInt(3), args[1][5])
+	require.Equal(t, token, args[1][6])
+	sigs = *abi.ConvertType(args[1][1], new([]ValSignature)).(*[]ValSignature)
+	require.Len(t, sigs, 3)
+	for _, sig := range sigs {
+		require.NotZero(t, sig.V)
+	}
+
+	addresses, signatures = signed(call.GetCheckpoint(gravityID), 2)
+	queryClient.contractCallConfirmations[1] = append(queryClient.contractCallConfirmations[1], &types.ContractCallTxConfirmation{
+		InvalidationScope: call.InvalidationScope, InvalidationNonce: 1, EthereumSigner: addresses[0], Signature: signatures[0],
+	})
+	queryClient.batches = nil
+	require.NoError(t, relayer.Step(ctx))
+	methods, args = calls(t, gravity, relayerAccount.From)
+	require.Equal(t, []string{contracts.UpdateValsetMethodName, contracts.SubmitBatchMethodName, contracts.SubmitLogicCallMethodName}, methods)
+	// the mined txs are no longer pending
+	queryClient.calls = nil
+	require.NoError(t, relayer.Step(ctx))
+	require.Empty(t, relayer.pending)
+
+	logicCall := *abi.ConvertType(args[2][2], new(LogicCallArgs)).(*LogicCallArgs)
+	require.Equal(t, payload, logicCall.Payload)
+	require.Equal(t, big.NewInt(1), logicCall.InvalidationNonce)
+	sigs = *abi.ConvertType(args[2][1], new([]ValSignature)).(*[]ValSignature)
+	// the signatures follow the members of the current signer set
+	require.NotZero(t, sigs[0].V)
+	require.Zero(t, sigs[1].V)
+	require.NotZero(t, sigs[2].V)
+}
@@ -13,21 +13,27 @@ import (
 // NOTE: begin blocker also emits events which are helpful for
 // clients listening to the chain and creating transactions
 // based on the events (i.e. orchestrators)
+// Each registered EVM chain is processed in turn, the default chain first.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
-	cleanupTimedOutBatchTxs(ctx, k)
-	cleanupTimedOutContractCallTxs(ctx, k)
-	createSignerSetTxs(ctx, k)
-	createBatchTxs(ctx, k)
-	pruneSignerSetTxs(ctx, k)
-	pruneCompletedOutgoingTxs(ctx, k)
-	pruneEthereumEventVoteRecords(ctx, k)
+	for _, ck := range k.EVMChainKeepers(ctx) {
+		cleanupTimedOutBatchTxs(ctx, ck)
+		cleanupTimedOutContractCallTxs(ctx, ck)
+		createSignerSetTxs(ctx, ck)
+		createBatchTxs(ctx, ck)
+		pruneSignerSetTxs(ctx, ck)
+		pruneCompletedOutgoingTxs(ctx, ck)
+		pruneEthereumEventVoteRecords(ctx, ck)
+	}
 }
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	outgoingTxSlashing(ctx, k)
-	eventVoteRecordTally(ctx, k)
-	updateObservedEthereumHeight(ctx, k)
+	for _, ck := range k.EVMChainKeepers(ctx) {
+		outgoingTxSlashing(ctx, ck)
+		eventVoteRecordTally(ctx, ck)
+		updateObservedEthereumHeight(ctx, ck)
+		completeBridgeMigration(ctx, ck)
+	}
 }
 
 func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
@@ -55,31 +61,26 @@ func createBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 func createSignerSetTxs(ctx sdk.Context, k keeper.Keeper) {
 	// Auto signerset tx creation.
 	// 1. If there are no signer set requests, create a new one.
-	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
+	// 2. If there is at least one validator holding more than the unbonding power fraction who started unbonding
+	//    in current block. (we persist last unbonded block height in hooks.go)
 	//      This will make sure the unbonding validator has to provide an ethereum signature to a new signer set tx
 	//	    that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of Current signer set and latest signer set request is > 5%
-	latestSignerSetTx := k.GetLatestSignerSetTx(ctx)
-	if latestSignerSetTx == nil {
-		k.CreateSignerSetTx(ctx)
-		return
-	}
-
-	lastUnbondingHeight := k.GetLastUnbondingBlockHeight(ctx)
+	// 3. If power change between validators of Current signer set and latest signer set request is above the
+	//    power diff threshold
+	// 4. If the latest signer set request has reached the signer set max age
+	// 5. If a validator rotated its ethereum key since the latest signer set request
 	blockHeight := uint64(ctx.BlockHeight())
-	powerDiff := types.EthereumSigners(k.CurrentSignerSet(ctx)).PowerDiff(latestSignerSetTx.Signers)
-
-	shouldCreate := (lastUnbondingHeight == blockHeight) || (powerDiff > 0.05)
+	drift := k.GetSignerSetDrift(ctx, blockHeight)
 	k.Logger(ctx).Info(
 		"considering signer set tx creation",
 		"blockHeight", blockHeight,
-		"lastUnbondingHeight", lastUnbondingHeight,
-		"latestSignerSetTx.Nonce", latestSignerSetTx.Nonce,
-		"powerDiff", powerDiff,
-		"shouldCreate", shouldCreate,
+		"latestSignerSetTx.Nonce", drift.LatestSignerSetNonce,
+		"powerDiff", drift.PowerDiff,
+		"shouldCreate", drift.WillCreateSignerSet,
+		"reason", drift.Reason,
 	)
 
-	if shouldCreate {
+	if drift.WillCreateSignerSet {
 		k.CreateSignerSetTx(ctx)
 	}
 }
@@ -282,6 +283,7 @@ func cleanupTimedOutBatchTxs(ctx sdk.Context, k keeper.Keeper) {
 			// we keep the data around for slashing in case the timeout was due to a lack of signatures.
 			k.CompleteOutgoingTx(ctx, btx)
 			k.CancelBatchTx(ctx, btx)
+			k.AfterBatchTimedOut(ctx, *btx)
 		}
 
 		return false
@@ -312,6 +314,28 @@ func cleanupTimedOutContractCallTxs(ctx sdk.Context, k keeper.Keeper) {
 	})
 }
 
+// completeBridgeMigration switches the bridge to the new contract once a bridge contract migration has drained every
+// in-flight batch and contract call. New batches and contract calls are frozen during the migration, so the bridge only
+// waits for those created beforehand to be executed on Ethereum or to time out, which returns their sends to the pool.
+func completeBridgeMigration(ctx sdk.Context, k keeper.Keeper) {
+	if k.GetBridgeMigration(ctx) == nil {
+		return
+	}
+
+	inFlight := false
+	for _, prefixByte := range []byte{types.BatchTxPrefixByte, types.ContractCallTxPrefixByte} {
+		k.IterateOutgoingTxsByType(ctx, prefixByte, func(_ []byte, _ types.OutgoingTx) bool {
+			inFlight = true
+			return true
+		})
+	}
+	if inFlight {
+		return
+	}
+
+	k.CompleteBridgeMigration(ctx)
+}
+
 func outgoingTxSlashing(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	maxHeight := uint64(0)
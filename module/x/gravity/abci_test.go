@@ -374,6 +374,35 @@ func TestSignerSetTxEmission(t *testing.T) {
 	require.EqualValues(t, 2, len(gravityKeeper.GetSignerSetTxs(ctx)))
 }
 
+func TestSignerSetTxCreationPolicy(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+
+	params := gravityKeeper.GetParams(ctx)
+	params.SignerSetPowerDiffThreshold = sdk.NewDecWithPrec(10, 2)
+	params.SignerSetMaxAge = 10
+	gravityKeeper.SetParams(ctx, params)
+
+	// a power change above the default threshold is below the configured one
+	sstx := gravityKeeper.CreateSignerSetTx(ctx)
+	delta := float64(types.EthereumSigners(sstx.Signers).TotalPower()) * 0.08
+	sstx.Signers[0].Power = uint64(float64(sstx.Signers[0].Power) - delta/2)
+	sstx.Signers[1].Power = uint64(float64(sstx.Signers[1].Power) + delta/2)
+	gravityKeeper.SetOutgoingTx(ctx, sstx)
+
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+
+	// the signer set is recreated once it reaches the max age
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 1, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.EqualValues(t, 2, gravityKeeper.GetLatestSignerSetTxNonce(ctx))
+}
+
 func TestSignerSetTxSetting(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gk := input.GravityKeeper
@@ -456,6 +485,82 @@ func TestBatchTxTimeout(t *testing.T) {
 	require.NotNil(t, gotThirdBatch)
 }
 
+func TestBridgeMigration(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	gravityKeeper := input.GravityKeeper
+	msgServer := keeper.NewMsgServerImpl(gravityKeeper)
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		newBridgeAddr       = common.HexToAddress("0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+		authority, _        = sdk.AccAddressFromBech32(gravityKeeper.GetAuthority())
+		oldBridgeAddr       = gravityKeeper.GetParams(ctx).BridgeEthereumAddress
+	)
+
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+	input.AddSendToEthTxsToPoolWithFee(t, ctx, myTokenContractAddr, mySender, myReceiver, 6, 10)
+
+	ctx = ctx.WithBlockHeight(250)
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 500)
+	inFlight := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	require.NotNil(t, inFlight)
+
+	_, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), types.NewMsgMigrateBridgeContract(authority, newBridgeAddr.Hex(), 1000))
+	require.NoError(t, err)
+
+	// no new batch while the migration is in progress
+	require.Nil(t, gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2))
+
+	// the in-flight batch keeps the bridge draining
+	gravity.EndBlocker(ctx, gravityKeeper)
+	require.NotNil(t, gravityKeeper.GetBridgeMigration(ctx))
+	require.Equal(t, oldBridgeAddr, gravityKeeper.GetParams(ctx).BridgeEthereumAddress)
+
+	// the batch times out, returning its sends to the pool, and the migration completes
+	ctx = ctx.WithBlockHeight(251).WithEventManager(sdk.NewEventManager())
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, inFlight.Timeout+1)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Nil(t, gravityKeeper.GetOutgoingTx(ctx, inFlight.GetStoreIndex()))
+	gravity.EndBlocker(ctx, gravityKeeper)
+
+	require.Nil(t, gravityKeeper.GetBridgeMigration(ctx))
+	require.Equal(t, newBridgeAddr.Hex(), gravityKeeper.GetParams(ctx).BridgeEthereumAddress)
+	require.Equal(t, uint64(999), gravityKeeper.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight)
+	require.Equal(t, uint64(0), gravityKeeper.GetLastObservedEventNonce(ctx))
+
+	// every send was refunded and the new contract starts with a fresh signer set
+	require.Equal(t, allVouchers, input.BankKeeper.GetAllBalances(ctx, mySender))
+	var pooled int
+	gravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(*types.SendToEthereum) bool {
+		pooled++
+		return false
+	})
+	require.Zero(t, pooled)
+	signerSets := gravityKeeper.GetSignerSetTxs(ctx)
+	require.Len(t, signerSets, 1)
+	require.Equal(t, uint64(1), signerSets[0].Nonce)
+
+	var drained, completed bool
+	for _, event := range ctx.EventManager().Events() {
+		drained = drained || event.Type == "gravity.v1.EventBridgeMigrationDrained"
+		completed = completed || event.Type == "gravity.v1.EventBridgeMigrationCompleted"
+	}
+	require.True(t, drained)
+	require.True(t, completed)
+
+	// outbound traffic resumes
+	_, err = msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+		Sender:            mySender.String(),
+		EthereumRecipient: myReceiver.Hex(),
+		Amount:            types.NewERC20Token(100, myTokenContractAddr).GravityCoin(),
+		BridgeFee:         types.NewERC20Token(1, myTokenContractAddr).GravityCoin(),
+	})
+	require.NoError(t, err)
+}
+
 func TestUpdateObservedEthereumHeight(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	gravityKeeper := input.GravityKeeper
@@ -54,6 +54,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdDelegateKeysByEthereumSigner(),
 		CmdDelegateKeysByOrchestrator(),
 		CmdDelegateKeys(),
+		CmdERC20DeploymentRequests(),
+		CmdSignerSetDrift(),
+		CmdSignerSetHijackIncidents(),
+		CmdEVMChains(),
+		CmdRelayableOutgoingTxs(),
 	)
 
 	return gravityQueryCmd
@@ -70,7 +75,12 @@ func CmdParams() *cobra.Command {
 				return err
 			}
 
-			req := types.ParamsRequest{}
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			req := types.ParamsRequest{EvmChainId: evmChainID}
 
 			res, err := queryClient.Params(cmd.Context(), &req)
 			if err != nil {
@@ -81,6 +91,7 @@ func CmdParams() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -96,12 +107,17 @@ func CmdSignerSetTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce})
+			res, err := queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -110,6 +126,7 @@ func CmdSignerSetTx() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -125,6 +142,11 @@ func CmdBatchTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			contractAddress, err := parseContractAddress(args[0])
 			if err != nil {
 				return nil
@@ -138,6 +160,7 @@ func CmdBatchTx() *cobra.Command {
 			res, err := queryClient.BatchTx(cmd.Context(), &types.BatchTxRequest{
 				TokenContract: contractAddress,
 				BatchNonce:    nonce,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -148,6 +171,7 @@ func CmdBatchTx() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -163,6 +187,11 @@ func CmdContractCallTx() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			// TODO: validate this scope somehow
 			invalidationScope := []byte(args[0])
 
@@ -174,6 +203,7 @@ func CmdContractCallTx() *cobra.Command {
 			res, err := queryClient.ContractCallTx(cmd.Context(), &types.ContractCallTxRequest{
 				InvalidationScope: invalidationScope,
 				InvalidationNonce: invalidationNonce,
+				EvmChainId:        evmChainID,
 			})
 
 			if err != nil {
@@ -184,6 +214,7 @@ func CmdContractCallTx() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -199,12 +230,17 @@ func CmdSignerSetTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{Pagination: pageReq})
+			res, err := queryClient.SignerSetTxs(cmd.Context(), &types.SignerSetTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -213,6 +249,7 @@ func CmdSignerSetTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "signer-set-txs")
 	return cmd
@@ -229,12 +266,17 @@ func CmdBatchTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq})
+			res, err := queryClient.BatchTxs(cmd.Context(), &types.BatchTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -243,6 +285,7 @@ func CmdBatchTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "batch-txs")
 	return cmd
@@ -259,12 +302,17 @@ func CmdContractCallTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{Pagination: pageReq})
+			res, err := queryClient.ContractCallTxs(cmd.Context(), &types.ContractCallTxsRequest{Pagination: pageReq, EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -273,6 +321,7 @@ func CmdContractCallTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract-call-txs")
 	return cmd
@@ -289,6 +338,11 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
@@ -296,6 +350,7 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 
 			res, err := queryClient.SignerSetTxConfirmations(cmd.Context(), &types.SignerSetTxConfirmationsRequest{
 				SignerSetNonce: nonce,
+				EvmChainId:     evmChainID,
 			})
 			if err != nil {
 				return err
@@ -305,6 +360,7 @@ func CmdSignerSetTxConfirmations() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -320,6 +376,11 @@ func CmdBatchTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			nonce, err := parseNonce(args[0])
 			if err != nil {
 				return err
@@ -333,6 +394,7 @@ func CmdBatchTxConfirmations() *cobra.Command {
 			res, err := queryClient.BatchTxConfirmations(cmd.Context(), &types.BatchTxConfirmationsRequest{
 				BatchNonce:    nonce,
 				TokenContract: contractAddress,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -343,6 +405,7 @@ func CmdBatchTxConfirmations() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -358,6 +421,11 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			// TODO: some sort of validation here?
 			invalidationScope := []byte(args[0])
 
@@ -369,6 +437,7 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 			res, err := queryClient.ContractCallTxConfirmations(cmd.Context(), &types.ContractCallTxConfirmationsRequest{
 				InvalidationNonce: invalidationNonce,
 				InvalidationScope: invalidationScope,
+				EvmChainId:        evmChainID,
 			})
 
 			if err != nil {
@@ -379,6 +448,7 @@ func CmdContractCallTxConfirmations() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -394,13 +464,19 @@ func CmdUnsignedSignerSetTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedSignerSetTxs(cmd.Context(), &types.UnsignedSignerSetTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -411,6 +487,7 @@ func CmdUnsignedSignerSetTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -426,13 +503,19 @@ func CmdUnsignedBatchTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedBatchTxs(cmd.Context(), &types.UnsignedBatchTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -443,6 +526,7 @@ func CmdUnsignedBatchTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -458,13 +542,19 @@ func CmdUnsignedContractCallTxs() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.UnsignedContractCallTxs(cmd.Context(), &types.UnsignedContractCallTxsRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -475,6 +565,7 @@ func CmdUnsignedContractCallTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -490,7 +581,12 @@ func CmdLatestSignerSetTx() *cobra.Command {
 				return err
 			}
 
-			req := &types.LatestSignerSetTxRequest{}
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.LatestSignerSetTxRequest{EvmChainId: evmChainID}
 
 			res, err := queryClient.LatestSignerSetTx(cmd.Context(), req)
 			if err != nil {
@@ -501,6 +597,7 @@ func CmdLatestSignerSetTx() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -516,13 +613,19 @@ func CmdLastSubmittedEthereumEvent() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			address, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.LastSubmittedEthereumEvent(cmd.Context(), &types.LastSubmittedEthereumEventRequest{
-				Address: address.String(),
+				Address:    address.String(),
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -533,6 +636,7 @@ func CmdLastSubmittedEthereumEvent() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -549,7 +653,12 @@ func CmdBatchTxFees() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchTxFees(cmd.Context(), &types.BatchTxFeesRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -558,6 +667,7 @@ func CmdBatchTxFees() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -573,13 +683,19 @@ func CmdERC20ToDenom() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			contract, err := parseContractAddress(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.ERC20ToDenom(cmd.Context(), &types.ERC20ToDenomRequest{
-				Erc20: contract,
+				Erc20:      contract,
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -590,6 +706,7 @@ func CmdERC20ToDenom() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -605,12 +722,18 @@ func CmdDenomToERC20Params() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			if err := sdk.ValidateDenom(args[0]); err != nil {
 				return err
 			}
 
 			req := &types.DenomToERC20ParamsRequest{
-				Denom: args[0],
+				Denom:      args[0],
+				EvmChainId: evmChainID,
 			}
 
 			res, err := queryClient.DenomToERC20Params(cmd.Context(), req)
@@ -622,6 +745,7 @@ func CmdDenomToERC20Params() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -637,12 +761,18 @@ func CmdDenomToERC20() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			if err := sdk.ValidateDenom(args[0]); err != nil {
 				return err
 			}
 
 			res, err := queryClient.DenomToERC20(cmd.Context(), &types.DenomToERC20Request{
-				Denom: args[0],
+				Denom:      args[0],
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -653,6 +783,7 @@ func CmdDenomToERC20() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -668,6 +799,11 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -681,6 +817,7 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 			res, err := queryClient.UnbatchedSendToEthereums(cmd.Context(), &types.UnbatchedSendToEthereumsRequest{
 				SenderAddress: sender.String(),
 				Pagination:    pageReq,
+				EvmChainId:    evmChainID,
 			})
 
 			if err != nil {
@@ -691,6 +828,7 @@ func CmdUnbatchedSendToEthereums() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "unbatched-send-to-ethereums")
 	return cmd
@@ -814,6 +952,120 @@ func CmdDelegateKeys() *cobra.Command {
 	return cmd
 }
 
+func CmdERC20DeploymentRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-deployment-requests",
+		Args:  cobra.NoArgs,
+		Short: "query ERC20 deployments that have been requested but not yet observed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ERC20DeploymentRequests(cmd.Context(), &types.ERC20DeploymentRequestsRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addEVMChainIDFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetDrift() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-drift",
+		Args:  cobra.NoArgs,
+		Short: "query the power drift from the latest signer set tx and whether the next block will create a new one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetDrift(cmd.Context(), &types.SignerSetDriftRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addEVMChainIDFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSignerSetHijackIncidents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-set-hijack-incidents",
+		Args:  cobra.NoArgs,
+		Short: "query the executed signer sets that did not match this chain's signer sets, and whether the bridge is paused",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SignerSetHijackIncidents(cmd.Context(), &types.SignerSetHijackIncidentsRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addEVMChainIDFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdEVMChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-chains",
+		Args:  cobra.NoArgs,
+		Short: "query the bridge chain ids of the EVM chains connected to this chain, and the default one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EVMChains(cmd.Context(), &types.EVMChainsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdLastObservedEthereumHeight() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "last-observed-ethereum-height",
@@ -825,7 +1077,12 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LastObservedEthereumHeight(cmd.Context(), &types.LastObservedEthereumHeightRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -834,6 +1091,7 @@ func CmdLastObservedEthereumHeight() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -849,7 +1107,12 @@ func CmdCompletedBatchTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.CompletedBatchTxs(cmd.Context(), &types.CompletedBatchTxsRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CompletedBatchTxs(cmd.Context(), &types.CompletedBatchTxsRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -858,6 +1121,7 @@ func CmdCompletedBatchTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -873,7 +1137,12 @@ func CmdCompletedContractCallTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.CompletedContractCallTxs(cmd.Context(), &types.CompletedContractCallTxsRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CompletedContractCallTxs(cmd.Context(), &types.CompletedContractCallTxsRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -882,6 +1151,7 @@ func CmdCompletedContractCallTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -897,7 +1167,12 @@ func CmdCompletedSignerSetTxs() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.CompletedSignerSetTxs(cmd.Context(), &types.CompletedSignerSetTxsRequest{})
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CompletedSignerSetTxs(cmd.Context(), &types.CompletedSignerSetTxsRequest{EvmChainId: evmChainID})
 			if err != nil {
 				return err
 			}
@@ -906,6 +1181,7 @@ func CmdCompletedSignerSetTxs() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -921,6 +1197,11 @@ func CmdBatchTxConfirmationsByValidator() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			val, err := sdk.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -928,6 +1209,7 @@ func CmdBatchTxConfirmationsByValidator() *cobra.Command {
 
 			res, err := queryClient.BatchTxConfirmationsByValidator(cmd.Context(), &types.BatchTxConfirmationsByValidatorRequest{
 				ValidatorAddress: val.String(),
+				EvmChainId:       evmChainID,
 			})
 
 			if err != nil {
@@ -938,6 +1220,7 @@ func CmdBatchTxConfirmationsByValidator() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -953,6 +1236,11 @@ func CmdContractCallTxConfirmationsByValidator() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			val, err := sdk.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -960,6 +1248,7 @@ func CmdContractCallTxConfirmationsByValidator() *cobra.Command {
 
 			res, err := queryClient.ContractCallTxConfirmationsByValidator(cmd.Context(), &types.ContractCallTxConfirmationsByValidatorRequest{
 				ValidatorAddress: val.String(),
+				EvmChainId:       evmChainID,
 			})
 
 			if err != nil {
@@ -970,6 +1259,7 @@ func CmdContractCallTxConfirmationsByValidator() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -985,6 +1275,11 @@ func CmdSignerSetTxConfirmationsByValidator() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			val, err := sdk.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -992,6 +1287,7 @@ func CmdSignerSetTxConfirmationsByValidator() *cobra.Command {
 
 			res, err := queryClient.SignerSetTxConfirmationsByValidator(cmd.Context(), &types.SignerSetTxConfirmationsByValidatorRequest{
 				ValidatorAddress: val.String(),
+				EvmChainId:       evmChainID,
 			})
 
 			if err != nil {
@@ -1002,6 +1298,7 @@ func CmdSignerSetTxConfirmationsByValidator() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -1017,6 +1314,11 @@ func CmdEthereumEventVotes() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -1024,6 +1326,7 @@ func CmdEthereumEventVotes() *cobra.Command {
 
 			res, err := queryClient.EthereumEventVoteRecords(cmd.Context(), &types.EthereumEventVoteRecordsRequest{
 				Pagination: pageReq,
+				EvmChainId: evmChainID,
 			})
 
 			if err != nil {
@@ -1034,6 +1337,7 @@ func CmdEthereumEventVotes() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all ethereum event vote records")
 	return cmd
@@ -1050,6 +1354,11 @@ func CmdEthereumEventVotesByValidator() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			val, err := sdk.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
@@ -1057,6 +1366,7 @@ func CmdEthereumEventVotesByValidator() *cobra.Command {
 
 			res, err := queryClient.EthereumEventVotes(cmd.Context(), &types.EthereumEventVotesRequest{
 				ValidatorAddress: val.String(),
+				EvmChainId:       evmChainID,
 			})
 
 			if err != nil {
@@ -1067,6 +1377,7 @@ func CmdEthereumEventVotesByValidator() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -1101,3 +1412,33 @@ func parseNonce(s string) (uint64, error) {
 	}
 	return nonce, nil
 }
+
+func CmdRelayableOutgoingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayable-outgoing-txs",
+		Args:  cobra.NoArgs,
+		Short: "query the outgoing txs signed by enough of the last observed signer set to be relayed, with their ordered signatures",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, queryClient, err := newContextAndQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RelayableOutgoingTxs(cmd.Context(), &types.RelayableOutgoingTxsRequest{EvmChainId: evmChainID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addEVMChainIDFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
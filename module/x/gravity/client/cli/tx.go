@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
@@ -31,6 +32,16 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEthereum(),
 		CmdCancelSendToEthereum(),
 		CmdSetDelegateKeys(),
+		CmdDelegateKeysTypedData(),
+		CmdRotateDelegateKeys(),
+		CmdAddOrchestrator(),
+		CmdRemoveOrchestrator(),
+		CmdRegisterIBCForward(),
+		CmdResyncEventNonce(),
+		CmdRequestERC20Deployment(),
+		CmdConfirmSignerSet(),
+		CmdConfirmBatch(),
+		CmdConfirmContractCall(),
 	)
 
 	return gravityTxCmd
@@ -68,7 +79,13 @@ func CmdSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendToEthereum(from, common.HexToAddress(args[0]).Hex(), sendCoin, feeCoin)
+			msg.EvmChainId = evmChainID
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -77,6 +94,7 @@ func CmdSendToEthereum() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -102,7 +120,13 @@ func CmdCancelSendToEthereum() *cobra.Command {
 				return err
 			}
 
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCancelSendToEthereum(id, from)
+			msg.EvmChainId = evmChainID
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -111,6 +135,7 @@ func CmdCancelSendToEthereum() *cobra.Command {
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -122,7 +147,11 @@ func CmdSetDelegateKeys() *cobra.Command {
 		Short: "Set gravity delegate keys",
 		Long: `Set a validator's Ethereum and orchestrator addresses. The validator must
 sign over a binary Proto-encoded DelegateKeysSignMsg message. The message contains
-the validator's address and operator account current nonce.`,
+the validator's address and operator account current nonce.
+
+With --signature-version 1, the Ethereum signature is an EIP-712 typed data
+signature instead, as produced by hardware wallets from the output of
+delegate-keys-typed-data.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -149,7 +178,280 @@ the validator's address and operator account current nonce.`,
 				return err
 			}
 
+			sigVersion, err := cmd.Flags().GetUint32(FlagSignatureVersion)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDelegateKeys(valAddr, orcAddr, ethAddr, ethSig)
+			msg.SignatureVersion = sigVersion
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagSignatureVersion, types.DelegateKeysSignatureVersionProto, "the scheme of the ethereum signature, 0 for the proto encoded DelegateKeysSignMsg, 1 for EIP-712 typed data")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdDelegateKeysTypedData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-keys-typed-data [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Print the EIP-712 typed data to sign for set-delegate-keys",
+		Long: `Print the EIP-712 typed data the validator's Ethereum key signs for
+set-delegate-keys --signature-version 1, in the JSON form of eth_signTypedData_v4.
+The typed data contains the validator's address, the current sequence of the
+validator account and the bridge chain id of the default EVM chain, which are
+queried from the node unless --nonce and --bridge-chain-id are given. The
+sequence must not change before set-delegate-keys is submitted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagNonce) {
+				if _, nonce, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(valAddr)); err != nil {
+					return err
+				}
+			}
+
+			chainID, err := cmd.Flags().GetUint64(FlagBridgeChainID)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagBridgeChainID) {
+				res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.ParamsRequest{})
+				if err != nil {
+					return err
+				}
+				chainID = res.Params.BridgeChainId
+			}
+
+			bz, err := DelegateKeysTypedDataJSON(valAddr.String(), nonce, chainID)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagNonce, 0, "the sequence of the validator account, queried from the node if not set")
+	cmd.Flags().Uint64(FlagBridgeChainID, 0, "the bridge chain id of the default EVM chain, queried from the node if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRotateDelegateKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate-keys [validator-address] [orchestrator-address] [ethereum-address] [old-ethereum-signature] [new-ethereum-signature]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Rotate gravity delegate keys",
+		Long: `Replace a validator's Ethereum and orchestrator addresses. Both the current and
+the new Ethereum keys must sign over a binary Proto-encoded DelegateKeysRotationSignMsg
+message. The message contains the validator's address, the new orchestrator and
+Ethereum addresses and the operator account current nonce.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ethAddr, err := parseContractAddress(args[2])
+			if err != nil {
+				return err
+			}
+
+			oldEthSig, err := hexutil.Decode(args[3])
+			if err != nil {
+				return err
+			}
+
+			newEthSig, err := hexutil.Decode(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateDelegateKeys(valAddr, orcAddr, ethAddr, oldEthSig, newEthSig)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAddOrchestrator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-orchestrator [validator-address] [orchestrator-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register an additional orchestrator account for a validator",
+		Long: `Register an orchestrator account that can submit Ethereum events and
+confirmations on behalf of the validator, alongside the orchestrator of its
+delegate keys. This lets standby orchestrators use their own account.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddOrchestrator(valAddr, orcAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRemoveOrchestrator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-orchestrator [validator-address] [orchestrator-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove an additional orchestrator account of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			orcAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveOrchestrator(valAddr, orcAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdResyncEventNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resync-event-nonce",
+		Args:  cobra.NoArgs,
+		Short: "Move the signer's validator event nonce forward to the last observed event nonce",
+		Long: `Move the last event nonce recorded for the signer's validator forward to the
+chain's last observed event nonce. This allows an orchestrator that has fallen
+behind, for example after losing its database, to resume voting on new Ethereum
+events without replaying every event it missed. The signer must be the validator
+or its registered orchestrator, and the validator must be behind.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResyncEventNonce(from)
+			msg.EvmChainId = evmChainID
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addEVMChainIDFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRequestERC20Deployment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-erc20-deployment [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Request an ERC20 representation of a Cosmos-originated denom",
+		Long: `Request an ERC20 representation of a Cosmos-originated denom. The expected
+ERC20 name, symbol and decimals are derived from the denom's bank metadata and
+recorded as a pending deployment request until a matching ERC20 deployment is
+observed on Ethereum. The denom cannot be sent to Ethereum until then.
+
+Only denoms with bank metadata, or with ERC20 metadata registered by governance,
+can be requested.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestERC20Deployment(from, args[0])
+			msg.EvmChainId = evmChainID
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -158,6 +460,225 @@ the validator's address and operator account current nonce.`,
 		},
 	}
 
+	addEVMChainIDFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRegisterIBCForward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-ibc-forward [channel] [receiver]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register an account forwarding the coins sent from Ethereum over IBC",
+		Long: `Register an account whose deposits from Ethereum are sent on to the receiver
+over the IBC channel. The account is derived from the forward and returned in
+the tx response, it is the cosmos destination to use with sendToCosmos. Register
+the forward before sending to its account, the coins sent to it before are lost.
+
+The fallback account receives the coins if the transfer cannot be initiated,
+and any refund. It defaults to the receiver's address bytes on this chain.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			if from == nil {
+				return fmt.Errorf("must pass from flag")
+			}
+
+			fallback, err := cmd.Flags().GetString(FlagFallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterIBCForward(from, types.IBCForward{
+				Receiver: args[1],
+				Channel:  args[0],
+				Fallback: fallback,
+				Memo:     memo,
+			})
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFallback, "", "the account receiving the coins if the forward fails, the receiver's address bytes if empty")
+	cmd.Flags().String(FlagMemo, "", "the memo of the IBC transfers")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmSignerSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-signer-set [nonce]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sign a signer set tx with an ethereum key and submit the confirmation",
+		Long: `Sign the checkpoint of a signer set tx with the ethereum key of the
+--ethereum-keystore file and submit the confirmation, without an orchestrator.
+The signer set tx and the gravity id are queried from the node. With --offline,
+they are read from the --outgoing-tx file, as printed by query gravity
+signer-set-tx, and the --gravity-id flag instead, and the tx is signed with the
+--account-number and --sequence flags.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[0])
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			var res types.SignerSetTxResponse
+			if err = queryOrReadOutgoingTx(cmd, clientCtx, &res, func(queryClient types.QueryClient) error {
+				qres, err := queryClient.SignerSetTx(cmd.Context(), &types.SignerSetTxRequest{SignerSetNonce: nonce, EvmChainId: evmChainID})
+				if err == nil {
+					res = *qres
+				}
+				return err
+			}); err != nil {
+				return err
+			}
+			if res.SignerSet == nil || res.SignerSet.Nonce != nonce {
+				return fmt.Errorf("signer set tx %d not found", nonce)
+			}
+
+			return confirmOutgoingTx(cmd, clientCtx, evmChainID, res.SignerSet)
+		},
+	}
+
+	addConfirmFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-batch [contract-address] [nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Sign a batch tx with an ethereum key and submit the confirmation",
+		Long: `Sign the checkpoint of a batch tx with the ethereum key of the
+--ethereum-keystore file and submit the confirmation, without an orchestrator.
+The batch tx and the gravity id are queried from the node. With --offline,
+they are read from the --outgoing-tx file, as printed by query gravity
+batch-tx, and the --gravity-id flag instead, and the tx is signed with the
+--account-number and --sequence flags.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddress, err := parseContractAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			var res types.BatchTxResponse
+			if err = queryOrReadOutgoingTx(cmd, clientCtx, &res, func(queryClient types.QueryClient) error {
+				qres, err := queryClient.BatchTx(cmd.Context(), &types.BatchTxRequest{
+					TokenContract: contractAddress,
+					BatchNonce:    nonce,
+					EvmChainId:    evmChainID,
+				})
+				if err == nil {
+					res = *qres
+				}
+				return err
+			}); err != nil {
+				return err
+			}
+			if res.Batch == nil || res.Batch.BatchNonce != nonce || !strings.EqualFold(res.Batch.TokenContract, contractAddress) {
+				return fmt.Errorf("batch tx %s/%d not found", contractAddress, nonce)
+			}
+
+			return confirmOutgoingTx(cmd, clientCtx, evmChainID, res.Batch)
+		},
+	}
+
+	addConfirmFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdConfirmContractCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-contract-call [invalidation-scope] [invalidation-nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Sign a contract call tx with an ethereum key and submit the confirmation",
+		Long: `Sign the checkpoint of a contract call tx with the ethereum key of the
+--ethereum-keystore file and submit the confirmation, without an orchestrator.
+The contract call tx and the gravity id are queried from the node. With --offline,
+they are read from the --outgoing-tx file, as printed by query gravity
+contract-call-tx, and the --gravity-id flag instead, and the tx is signed with the
+--account-number and --sequence flags.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			invalidationScope := []byte(args[0])
+
+			invalidationNonce, err := parseNonce(args[1])
+			if err != nil {
+				return err
+			}
+
+			evmChainID, err := cmd.Flags().GetUint64(FlagEVMChainID)
+			if err != nil {
+				return err
+			}
+
+			var res types.ContractCallTxResponse
+			if err = queryOrReadOutgoingTx(cmd, clientCtx, &res, func(queryClient types.QueryClient) error {
+				qres, err := queryClient.ContractCallTx(cmd.Context(), &types.ContractCallTxRequest{
+					InvalidationScope: invalidationScope,
+					InvalidationNonce: invalidationNonce,
+					EvmChainId:        evmChainID,
+				})
+				if err == nil {
+					res = *qres
+				}
+				return err
+			}); err != nil {
+				return err
+			}
+			if res.LogicCall == nil || res.LogicCall.InvalidationNonce != invalidationNonce || !bytes.Equal(res.LogicCall.InvalidationScope, invalidationScope) {
+				return fmt.Errorf("contract call tx %s/%d not found", args[0], invalidationNonce)
+			}
+
+			return confirmOutgoingTx(cmd, clientCtx, evmChainID, res.LogicCall)
+		},
+	}
+
+	addConfirmFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -248,3 +769,138 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+func CmdSubmitERC20MetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal setting the bank metadata of an Ethereum-originated token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting the bank metadata of the vouchers for an
+Ethereum-originated ERC20, along with an initial deposit. The proposal details must be
+supplied via a JSON file. Any metadata already registered for the vouchers is replaced.
+
+Example:
+$ %s tx gov submit-proposal erc20-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "USDC Metadata",
+	"description": "Display USDC vouchers with 6 decimals",
+	"token_contract": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+	"name": "USD Coin",
+	"symbol": "USDC",
+	"decimals": 6,
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseERC20MetadataProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewERC20MetadataProposal(proposal.Title, proposal.Description, proposal.TokenContract, types.ERC20Metadata{
+				Name:     proposal.Name,
+				Symbol:   proposal.Symbol,
+				Decimals: proposal.Decimals,
+			})
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func CmdSubmitIBCDenomMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal registering the ERC20 metadata of an IBC denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal registering the ERC20 name, symbol and decimals to deploy
+for an IBC denom, along with an initial deposit. The proposal details must be supplied via
+a JSON file. The registered metadata takes precedence over the denom's bank metadata and
+denom trace, and can only be set before the denom's ERC20 has been deployed.
+
+Example:
+$ %s tx gov submit-proposal ibc-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+	"title": "ATOM ERC20 Metadata",
+	"description": "Deploy IBC ATOM as a 6 decimal ERC20",
+	"denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	"name": "Cosmos Hub Atom",
+	"symbol": "ATOM",
+	"decimals": 6,
+	"deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseIBCDenomMetadataProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewIBCDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Denom, types.ERC20Metadata{
+				Name:     proposal.Name,
+				Symbol:   proposal.Symbol,
+				Decimals: proposal.Decimals,
+			})
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -1,11 +1,23 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
 func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
@@ -32,3 +44,143 @@ func TestParseCommunityPoolEthereumSpendProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.BridgeFee)
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+func TestParseERC20MetadataProposal(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "USDC Metadata",
+  "description": "Display USDC vouchers with 6 decimals",
+  "token_contract": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+  "name": "USD Coin",
+  "symbol": "USDC",
+  "decimals": 6,
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseERC20MetadataProposal(encodingConfig.Codec, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "USDC Metadata", proposal.Title)
+	require.Equal(t, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", proposal.TokenContract)
+	require.Equal(t, "USD Coin", proposal.Name)
+	require.Equal(t, "USDC", proposal.Symbol)
+	require.Equal(t, uint64(6), proposal.Decimals)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
+
+func TestParseIBCDenomMetadataProposal(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "title": "ATOM ERC20 Metadata",
+  "description": "Deploy IBC ATOM as a 6 decimal ERC20",
+  "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+  "name": "Cosmos Hub Atom",
+  "symbol": "ATOM",
+  "decimals": 6,
+  "deposit": "1000stake"
+}
+`)
+
+	proposal, err := ParseIBCDenomMetadataProposal(encodingConfig.Codec, okJSON.Name())
+	require.NoError(t, err)
+
+	require.Equal(t, "ATOM ERC20 Metadata", proposal.Title)
+	require.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", proposal.Denom)
+	require.Equal(t, "Cosmos Hub Atom", proposal.Name)
+	require.Equal(t, "ATOM", proposal.Symbol)
+	require.Equal(t, uint64(6), proposal.Decimals)
+	require.Equal(t, "1000stake", proposal.Deposit)
+}
+
+func TestDelegateKeysTypedDataJSON(t *testing.T) {
+	const validatorAddress = "cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+
+	bz, err := DelegateKeysTypedDataJSON(validatorAddress, 7, 137)
+	require.NoError(t, err)
+
+	// a wallet signing the JSON hashes the same typed data as the chain
+	var typedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal(bz, &typedData))
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	expected, err := types.DelegateKeysTypedDataHash(validatorAddress, 7, 137)
+	require.NoError(t, err)
+	require.Equal(t, expected, hash)
+	require.NotContains(t, string(bz), "verifyingContract")
+}
+
+func TestCmdConfirmSignerSetOffline(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    ethAddr,
+		PrivateKey: privKey,
+	}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	keystoreFile := testutil.WriteToNewTempFile(t, string(keyJSON))
+
+	signerSet := types.NewSignerSetTx(3, 100, types.EthereumSigners{{Power: 1, EthereumAddress: ethAddr.Hex()}})
+	resJSON, err := encodingConfig.Codec.MarshalJSON(&types.SignerSetTxResponse{SignerSet: signerSet})
+	require.NoError(t, err)
+	outgoingTxFile := testutil.WriteToNewTempFile(t, string(resJSON))
+
+	out := bytes.NewBuffer(nil)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithKeyring(keyring.NewInMemory(encodingConfig.Codec)).
+		WithOutput(out)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	from := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	cmd := CmdConfirmSignerSet()
+	cmd.SetArgs([]string{
+		"3",
+		"--offline",
+		"--generate-only",
+		"--account-number", "1",
+		"--sequence", "1",
+		"--from", from.String(),
+		"--gravity-id", "testgravityid",
+		"--outgoing-tx", outgoingTxFile.Name(),
+		"--ethereum-keystore", keystoreFile.Name(),
+		"--ethereum-passphrase", "secret",
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// the generated tx confirms the signer set with the ethereum key
+	stdTx, err := encodingConfig.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+	require.Len(t, stdTx.GetMsgs(), 1)
+	msg, ok := stdTx.GetMsgs()[0].(*types.MsgSubmitEthereumTxConfirmation)
+	require.True(t, ok)
+	require.Equal(t, from.String(), msg.Signer)
+
+	conf, err := types.UnpackConfirmation(msg.Confirmation)
+	require.NoError(t, err)
+	require.Equal(t, ethAddr, conf.GetSigner())
+	require.NoError(t, types.ValidateEthereumSignature(signerSet.GetCheckpoint([]byte("testgravityid")), conf.GetSignature(), ethAddr))
+
+	// offline confirmations need the gravity id and the outgoing tx of the flags
+	cmd = CmdConfirmSignerSet()
+	cmd.SetErr(bytes.NewBuffer(nil))
+	cmd.SetArgs([]string{"3", "--offline", "--generate-only", "--account-number", "1", "--sequence", "1", "--from", from.String(), "--outgoing-tx", outgoingTxFile.Name()})
+	require.Error(t, cmd.ExecuteContext(ctx))
+
+	cmd = CmdConfirmSignerSet()
+	cmd.SetErr(bytes.NewBuffer(nil))
+	cmd.SetArgs([]string{"4", "--offline", "--generate-only", "--account-number", "1", "--sequence", "1", "--from", from.String(), "--gravity-id", "testgravityid", "--outgoing-tx", outgoingTxFile.Name()})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
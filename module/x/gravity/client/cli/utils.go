@@ -1,12 +1,181 @@
 package cli
 
 import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
+// FlagEVMChainID selects the EVM chain of the commands reading or changing its state
+const FlagEVMChainID = "evm-chain-id"
+
+// addEVMChainIDFlag adds the flag selecting the EVM chain to a command
+func addEVMChainIDFlag(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagEVMChainID, 0, "the bridge chain id of the EVM chain, the default chain if 0")
+}
+
+const (
+	// FlagSignatureVersion selects the scheme of the ethereum signature of set-delegate-keys
+	FlagSignatureVersion = "signature-version"
+	// FlagNonce sets the validator account sequence of delegate-keys-typed-data
+	FlagNonce = "nonce"
+	// FlagBridgeChainID sets the bridge chain id of delegate-keys-typed-data
+	FlagBridgeChainID = "bridge-chain-id"
+	// FlagFallback sets the fallback account of register-ibc-forward
+	FlagFallback = "fallback"
+	// FlagMemo sets the ICS-20 memo of register-ibc-forward
+	FlagMemo = "memo"
+)
+
+// DelegateKeysTypedDataJSON returns the DelegateKeys EIP-712 typed data in the
+// JSON form of eth_signTypedData_v4, with only the domain fields in use
+func DelegateKeysTypedDataJSON(validatorAddress string, nonce uint64, chainID uint64) ([]byte, error) {
+	typedData := types.DelegateKeysTypedData(validatorAddress, nonce, chainID)
+	return json.MarshalIndent(map[string]interface{}{
+		"types":       typedData.Types,
+		"primaryType": typedData.PrimaryType,
+		"domain":      typedData.Domain.Map(),
+		"message":     typedData.Message,
+	}, "", "  ")
+}
+
+const (
+	// FlagEthereumKeystore sets the V3 keystore file of the ethereum key signing confirmations
+	FlagEthereumKeystore = "ethereum-keystore"
+	// FlagEthereumPassphrase sets the password of the ethereum keystore file
+	FlagEthereumPassphrase = "ethereum-passphrase"
+	// FlagGravityID sets the gravity id of the checkpoints signed offline
+	FlagGravityID = "gravity-id"
+	// FlagOutgoingTx sets the JSON file of the outgoing tx confirmed offline
+	FlagOutgoingTx = "outgoing-tx"
+)
+
+// addConfirmFlags adds the flags of the commands confirming outgoing txs
+func addConfirmFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagEthereumKeystore, "", "the V3 keystore file of the ethereum key, as shown by eth_keys show")
+	cmd.Flags().String(FlagEthereumPassphrase, "", "the password of the ethereum keystore file")
+	cmd.Flags().String(FlagGravityID, "", "the gravity id of the EVM chain, queried from the node if not set, required with --offline")
+	cmd.Flags().String(FlagOutgoingTx, "", "the outgoing tx JSON printed by the query command, required with --offline")
+	addEVMChainIDFlag(cmd)
+}
+
+// readEthereumKey decrypts the ethereum keystore file of the command
+func readEthereumKey(cmd *cobra.Command) (*ecdsa.PrivateKey, error) {
+	keystoreFile, err := cmd.Flags().GetString(FlagEthereumKeystore)
+	if err != nil {
+		return nil, err
+	}
+	if keystoreFile == "" {
+		return nil, fmt.Errorf("--%s is required", FlagEthereumKeystore)
+	}
+
+	passphrase, err := cmd.Flags().GetString(FlagEthereumPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	keyJSON, err := ioutil.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PrivateKey, nil
+}
+
+// ParseOutgoingTxResponse reads the JSON of an outgoing tx query response,
+// such as a SignerSetTxResponse, from a file
+func ParseOutgoingTxResponse(cdc codec.JSONCodec, outgoingTxFile string, res proto.Message) error {
+	contents, err := ioutil.ReadFile(outgoingTxFile)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(contents, res)
+}
+
+// queryOrReadOutgoingTx fills the outgoing tx query response from the node, or
+// from the --outgoing-tx file in offline mode
+func queryOrReadOutgoingTx(cmd *cobra.Command, clientCtx client.Context, res proto.Message, query func(types.QueryClient) error) error {
+	if !clientCtx.Offline {
+		return query(types.NewQueryClient(clientCtx))
+	}
+
+	outgoingTxFile, err := cmd.Flags().GetString(FlagOutgoingTx)
+	if err != nil {
+		return err
+	}
+	if outgoingTxFile == "" {
+		return fmt.Errorf("--%s is required in offline mode", FlagOutgoingTx)
+	}
+
+	return ParseOutgoingTxResponse(clientCtx.Codec, outgoingTxFile, res)
+}
+
+// confirmOutgoingTx signs the checkpoint of the outgoing tx with the ethereum
+// key of the command and broadcasts the confirmation
+func confirmOutgoingTx(cmd *cobra.Command, clientCtx client.Context, evmChainID uint64, otx types.OutgoingTx) error {
+	from := clientCtx.GetFromAddress()
+	if from == nil {
+		return fmt.Errorf("must pass from flag")
+	}
+
+	gravityID, err := cmd.Flags().GetString(FlagGravityID)
+	if err != nil {
+		return err
+	}
+	if gravityID == "" {
+		if clientCtx.Offline {
+			return fmt.Errorf("--%s is required in offline mode", FlagGravityID)
+		}
+		res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.ParamsRequest{EvmChainId: evmChainID})
+		if err != nil {
+			return err
+		}
+		gravityID = res.Params.GravityId
+	}
+
+	privateKey, err := readEthereumKey(cmd)
+	if err != nil {
+		return err
+	}
+
+	conf, err := types.NewEthereumTxConfirmation(otx, gravityID, privateKey)
+	if err != nil {
+		return err
+	}
+
+	confAny, err := types.PackConfirmation(conf)
+	if err != nil {
+		return err
+	}
+
+	msg := &types.MsgSubmitEthereumTxConfirmation{
+		Confirmation: confAny,
+		Signer:       from.String(),
+		EvmChainId:   evmChainID,
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
 // ParseCommunityPoolEthereumSpendProposal reads and parses a CommunityPoolEthereumSpendProposalForCLI from a file.
 func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile string) (types.CommunityPoolEthereumSpendProposalForCLI, error) {
 	proposal := types.CommunityPoolEthereumSpendProposalForCLI{}
@@ -22,3 +191,35 @@ func ParseCommunityPoolEthereumSpendProposal(cdc codec.JSONCodec, proposalFile s
 
 	return proposal, nil
 }
+
+// ParseERC20MetadataProposal reads and parses an ERC20MetadataProposalForCLI from a file.
+func ParseERC20MetadataProposal(cdc codec.JSONCodec, proposalFile string) (types.ERC20MetadataProposalForCLI, error) {
+	proposal := types.ERC20MetadataProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseIBCDenomMetadataProposal reads and parses an IBCDenomMetadataProposalForCLI from a file.
+func ParseIBCDenomMetadataProposal(cdc codec.JSONCodec, proposalFile string) (types.IBCDenomMetadataProposalForCLI, error) {
+	proposal := types.IBCDenomMetadataProposalForCLI{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -6,6 +6,10 @@ import (
 )
 
 // ProposalHandler is the community Ethereum spend proposal handler.
+// ERC20MetadataProposalHandler is the ERC20 voucher metadata proposal handler.
+// IBCDenomMetadataProposalHandler is the IBC denom ERC20 metadata proposal handler.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal)
+	ProposalHandler                 = govclient.NewProposalHandler(cli.CmdSubmitCommunityPoolEthereumSpendProposal)
+	ERC20MetadataProposalHandler    = govclient.NewProposalHandler(cli.CmdSubmitERC20MetadataProposal)
+	IBCDenomMetadataProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitIBCDenomMetadataProposal)
 )
@@ -42,6 +42,42 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.SubmitEthereumHeightVote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgResyncEventNonce:
+			res, err := msgServer.ResyncEventNonce(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRequestERC20Deployment:
+			res, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMigrateBridgeContract:
+			res, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRotateDelegateKeys:
+			res, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddOrchestrator:
+			res, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRemoveOrchestrator:
+			res, err := msgServer.RemoveOrchestrator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRegisterIBCForward:
+			res, err := msgServer.RegisterIBCForward(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgERC20MetadataVote:
+			res, err := msgServer.SubmitERC20MetadataVote(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, errors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -53,6 +89,10 @@ func NewCommunityPoolEthereumSpendProposalHandler(k keeper.Keeper) govtypes.Hand
 		switch c := content.(type) {
 		case *types.CommunityPoolEthereumSpendProposal:
 			return k.HandleCommunityPoolEthereumSpendProposal(ctx, c)
+		case *types.ERC20MetadataProposal:
+			return k.HandleERC20MetadataProposal(ctx, c)
+		case *types.IBCDenomMetadataProposal:
+			return k.HandleIBCDenomMetadataProposal(ctx, c)
 		default:
 			return errors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
 		}
@@ -116,7 +116,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err := types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent := &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent := &types.MsgSubmitEthereumEvent{Event: eva, Signer: myOrchestratorAddr.String()}
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
 	_, err = h(ctx, msgSubmitEvent)
@@ -154,7 +154,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err = types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent = &types.MsgSubmitEthereumEvent{Event: eva, Signer: myOrchestratorAddr.String()}
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -178,7 +178,7 @@ func TestMsgSubmitEthreumEventSendToCosmosSingleValidator(t *testing.T) {
 	eva, err = types.PackEvent(sendToCosmosEvent)
 	require.NoError(t, err)
 
-	msgSubmitEvent = &types.MsgSubmitEthereumEvent{eva, myOrchestratorAddr.String()}
+	msgSubmitEvent = &types.MsgSubmitEthereumEvent{Event: eva, Signer: myOrchestratorAddr.String()}
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
 	_, err = h(ctx, msgSubmitEvent)
@@ -231,7 +231,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim1a, err := types.PackEvent(ethClaim1)
 	require.NoError(t, err)
-	ethClaim1Msg := &types.MsgSubmitEthereumEvent{ethClaim1a, orchestratorAddr1.String()}
+	ethClaim1Msg := &types.MsgSubmitEthereumEvent{Event: ethClaim1a, Signer: orchestratorAddr1.String()}
 	ethClaim2 := &types.SendToCosmosEvent{
 		EventNonce:     myNonce,
 		TokenContract:  myErc20.Contract,
@@ -241,7 +241,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim2a, err := types.PackEvent(ethClaim2)
 	require.NoError(t, err)
-	ethClaim2Msg := &types.MsgSubmitEthereumEvent{ethClaim2a, orchestratorAddr2.String()}
+	ethClaim2Msg := &types.MsgSubmitEthereumEvent{Event: ethClaim2a, Signer: orchestratorAddr2.String()}
 	ethClaim3 := &types.SendToCosmosEvent{
 		EventNonce:     myNonce,
 		TokenContract:  myErc20.Contract,
@@ -251,7 +251,7 @@ func TestMsgSubmitEthreumEventSendToCosmosMultiValidator(t *testing.T) {
 	}
 	ethClaim3a, err := types.PackEvent(ethClaim3)
 	require.NoError(t, err)
-	ethClaim3Msg := &types.MsgSubmitEthereumEvent{ethClaim3a, orchestratorAddr3.String()}
+	ethClaim3Msg := &types.MsgSubmitEthereumEvent{Event: ethClaim3a, Signer: orchestratorAddr3.String()}
 
 	// when
 	ctx = ctx.WithBlockTime(myBlockTime)
@@ -0,0 +1,88 @@
+package gravity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+type outgoingTxHooks struct {
+	createdBatches    []types.BatchTx
+	timedOutBatches   []types.BatchTx
+	createdSignerSets []types.SignerSetTx
+	completed         []types.OutgoingTx
+}
+
+func (h *outgoingTxHooks) AfterContractCallExecutedEvent(sdk.Context, types.ContractCallExecutedEvent) {
+}
+func (h *outgoingTxHooks) AfterERC20DeployedEvent(sdk.Context, types.ERC20DeployedEvent)           {}
+func (h *outgoingTxHooks) AfterSignerSetExecutedEvent(sdk.Context, types.SignerSetTxExecutedEvent) {}
+func (h *outgoingTxHooks) AfterBatchExecutedEvent(sdk.Context, types.BatchExecutedEvent)           {}
+func (h *outgoingTxHooks) AfterSendToCosmosEvent(sdk.Context, types.SendToCosmosEvent)             {}
+
+func (h *outgoingTxHooks) AfterBatchCreated(_ sdk.Context, batch types.BatchTx) {
+	h.createdBatches = append(h.createdBatches, batch)
+}
+
+func (h *outgoingTxHooks) AfterBatchTimedOut(_ sdk.Context, batch types.BatchTx) {
+	h.timedOutBatches = append(h.timedOutBatches, batch)
+}
+
+func (h *outgoingTxHooks) AfterSignerSetTxCreated(_ sdk.Context, signerSet types.SignerSetTx) {
+	h.createdSignerSets = append(h.createdSignerSets, signerSet)
+}
+
+func (h *outgoingTxHooks) AfterOutgoingTxCompleted(_ sdk.Context, otx types.OutgoingTx) {
+	h.completed = append(h.completed, otx)
+}
+
+func TestOutgoingTxHooks(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	hooks := &outgoingTxHooks{}
+	input.GravityKeeper.SetHooks(types.NewMultiGravityHooks(hooks))
+	gravityKeeper := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn")
+		myReceiver          = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		allVouchers         = sdk.NewCoins(types.NewERC20Token(99999, myTokenContractAddr).GravityCoin())
+	)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, mySender, allVouchers))
+	input.AddSendToEthTxsToPoolWithFee(t, ctx, myTokenContractAddr, mySender, myReceiver, 6, 10)
+
+	// creation
+	signerSet := gravityKeeper.CreateSignerSetTx(ctx)
+	require.Len(t, hooks.createdSignerSets, 1)
+	require.Equal(t, signerSet.Nonce, hooks.createdSignerSets[0].Nonce)
+
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 500)
+	b1 := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	require.Len(t, hooks.createdBatches, 1)
+	require.Equal(t, b1.BatchNonce, hooks.createdBatches[0].BatchNonce)
+
+	// completion of a batch executed on ethereum
+	require.NoError(t, gravityKeeper.Handle(ctx, &types.BatchExecutedEvent{
+		TokenContract: myTokenContractAddr.Hex(),
+		EventNonce:    1,
+		BatchNonce:    b1.BatchNonce,
+	}))
+	require.Len(t, hooks.completed, 1)
+	require.Equal(t, b1.GetStoreIndex(), hooks.completed[0].GetStoreIndex())
+
+	// timeout of a batch that was never executed
+	b2 := gravityKeeper.CreateBatchTx(ctx, myTokenContractAddr, 2)
+	require.Len(t, hooks.createdBatches, 2)
+	gravityKeeper.SetLastObservedEthereumBlockHeight(ctx, 5000)
+	gravity.BeginBlocker(ctx, gravityKeeper)
+	require.Len(t, hooks.timedOutBatches, 1)
+	require.Equal(t, b2.BatchNonce, hooks.timedOutBatches[0].BatchNonce)
+}
@@ -0,0 +1,117 @@
+package gravity
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer application. Incoming transfers with
+// a gravity memo are received into an intermediate account and immediately
+// bridged on to Ethereum. All other packets and callbacks are passed through
+// to the wrapped application unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware returns a gravity middleware wrapping the transfer application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. If the packet memo asks for
+// the coins to be bridged to Ethereum and the send cannot be created, an error
+// acknowledgement is returned so that the coins are refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	send, ok, err := types.ParseIBCSendToEthereumMemo(data.Memo)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// refunds of the send go back to the packet sender over the channel the
+	// packet was received on, so the sender must be an address they can use
+	refund := types.IBCForward{Receiver: data.Sender, Channel: packet.GetDestChannel()}
+	if err := refund.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrapf(err, "packet sender %s cannot be refunded", data.Sender))
+	}
+
+	// receive the coins into the intermediate account rather than the packet receiver
+	intermediate := types.IBCSendToEthereumAccount(packet.GetDestChannel(), data.Sender)
+	data.Receiver = intermediate.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrInvalid, "packet amount %s", data.Amount))
+	}
+	if !amount.GT(send.BridgeFee) {
+		return channeltypes.NewErrorAcknowledgement(
+			errors.Wrapf(types.ErrInvalid, "packet amount %s must exceed the bridge fee %s", amount, send.BridgeFee),
+		)
+	}
+
+	denom := receivedDenom(packet, data.Denom)
+	txID, err := im.keeper.SendToEthereumFromIBC(
+		ctx,
+		intermediate,
+		send.EthereumRecipient,
+		sdk.NewCoin(denom, amount.Sub(send.BridgeFee)),
+		sdk.NewCoin(denom, send.BridgeFee),
+		refund,
+	)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	im.keeper.Logger(ctx).Info(
+		"ibc transfer bridged to ethereum",
+		"id", fmt.Sprint(txID),
+		"sender", data.Sender,
+		"ethereum recipient", send.EthereumRecipient,
+	)
+
+	return ack
+}
+
+// receivedDenom returns the denom that the transfer application credits on
+// this chain for the packet's denom, following the ICS-20 prefixing rules
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		unprefixed := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
@@ -0,0 +1,114 @@
+package gravity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// mockTransferApp credits the packet receiver with the received denom
+type mockTransferApp struct {
+	porttypes.IBCModule
+	input     keeper.TestInput
+	receivers []string
+}
+
+func (m *mockTransferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	m.receivers = append(m.receivers, data.Receiver)
+
+	denom := data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	receiver := sdk.MustAccAddressFromBech32(data.Receiver)
+	if err := m.input.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if err := m.input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+
+	var (
+		tokenContract  = common.HexToAddress("0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e")
+		denom          = types.GravityDenom(tokenContract)
+		receiver       = keeper.AccAddrs[0].String()
+		ethDestination = common.HexToAddress("0x3c9289da00b02dC623d0D8D907619890301D26d4").Hex()
+	)
+
+	sender, err := bech32.ConvertAndEncode("osmo", keeper.AccAddrs[1])
+	require.NoError(t, err)
+
+	app := &mockTransferApp{input: input}
+	middleware := gravity.NewIBCMiddleware(app, input.GravityKeeper)
+
+	packetFrom := func(sender, amount, memo string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("transfer/channel-5/"+denom, amount, sender, receiver, memo)
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-5", "transfer", "channel-0", clienttypes.ZeroHeight(), 1)
+	}
+	packet := func(amount, memo string) channeltypes.Packet {
+		return packetFrom(sender, amount, memo)
+	}
+	memo := func(fee string) string {
+		return `{"gravity":{"ethereum_recipient":"` + ethDestination + `","bridge_fee":"` + fee + `"}}`
+	}
+
+	// packets without a gravity memo are passed through unchanged
+	ack := middleware.OnRecvPacket(ctx, packet("100", `{"wasm":{}}`), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, []string{receiver}, app.receivers)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, keeper.AccAddrs[0], denom).Amount)
+
+	// a gravity memo bridges the coins from the intermediate account
+	intermediate := types.IBCSendToEthereumAccount("channel-0", sender)
+	ack = middleware.OnRecvPacket(ctx, packet("100", memo("10")), nil)
+	require.True(t, ack.Success())
+	require.Equal(t, intermediate.String(), app.receivers[1])
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, intermediate).IsZero())
+
+	var sends []*types.SendToEthereum
+	input.GravityKeeper.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		sends = append(sends, ste)
+		return false
+	})
+	require.Len(t, sends, 1)
+	require.Equal(t, intermediate.String(), sends[0].Sender)
+	require.Equal(t, ethDestination, sends[0].EthereumRecipient)
+	require.Equal(t, types.NewERC20Token(90, tokenContract), sends[0].Erc20Token)
+	require.Equal(t, types.NewERC20Token(10, tokenContract), sends[0].Erc20Fee)
+	require.Equal(t, "channel-0", sends[0].IbcChannel)
+	require.Equal(t, sender, sends[0].IbcSender)
+
+	// invalid memos and fees that consume the whole amount are refunded
+	ack = middleware.OnRecvPacket(ctx, packet("100", `{"gravity":{"ethereum_recipient":"0xnope"}}`), nil)
+	require.False(t, ack.Success())
+	ack = middleware.OnRecvPacket(ctx, packet("100", memo("100")), nil)
+	require.False(t, ack.Success())
+
+	// so are packets from senders that a refund could not be sent back to,
+	// before the coins are received
+	received := len(app.receivers)
+	ack = middleware.OnRecvPacket(ctx, packetFrom("osmo1sender", "100", memo("10")), nil)
+	require.False(t, ack.Success())
+	require.Len(t, app.receivers, received)
+}
@@ -23,7 +23,13 @@ const BatchTxSize = 100
 //   - if the existing batch is more profitable than the new batch would be, do not create a new batch
 //   - persist an OutgoingTx (BatchTx) object with an incrementing ID = nonce
 //   - emit an event
+//
+// No batch is created while a bridge contract migration is in progress or the bridge is paused.
 func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, maxElements int) *types.BatchTx {
+	if k.GetBridgeMigration(ctx) != nil || k.IsBridgePaused(ctx) {
+		return nil
+	}
+
 	var selectedStes []*types.SendToEthereum
 	k.iterateUnbatchedSendToEthereumsByContract(ctx, contractAddress, func(ste *types.SendToEthereum) bool {
 		selectedStes = append(selectedStes, ste)
@@ -91,6 +97,8 @@ func (k Keeper) CreateBatchTx(ctx sdk.Context, contractAddress common.Address, m
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
 	))
 
+	k.AfterBatchCreated(ctx, *batch)
+
 	return batch
 }
 
@@ -120,6 +128,7 @@ func (k Keeper) batchTxExecuted(ctx sdk.Context, tokenContract common.Address, n
 	})
 
 	k.CompleteOutgoingTx(ctx, batchTx)
+	k.AfterOutgoingTxCompleted(ctx, batchTx)
 }
 
 // CancelBatchTx releases all TX in the batch and deletes the batch
@@ -189,18 +198,24 @@ func (k Keeper) GetUnsignedBatchTxs(ctx sdk.Context, val sdk.ValAddress) []*type
 }
 
 func (k Keeper) incrementLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastOutgoingBatchNonceKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastOutgoingBatchNonceKey}, bz)
+	newId := k.getLastOutgoingBatchNonce(ctx) + 1
+	k.setLastOutgoingBatchNonce(ctx, newId)
 	return newId
 }
 
+// getLastOutgoingBatchNonce returns the nonce of the last batch created
+func (k Keeper) getLastOutgoingBatchNonce(ctx sdk.Context) uint64 {
+	if bz := k.chainStore(ctx).Get([]byte{types.LastOutgoingBatchNonceKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+// setLastOutgoingBatchNonce sets the nonce of the last batch created
+func (k Keeper) setLastOutgoingBatchNonce(ctx sdk.Context, nonce uint64) {
+	k.chainStore(ctx).Set([]byte{types.LastOutgoingBatchNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // orderBatchesByNonceAscending orders the batches by their BatchNonce in ascending order
 func orderBatchesByNonceAscending(batches []*types.BatchTx) []*types.BatchTx {
 	sort.Slice(batches, func(i, j int) bool {
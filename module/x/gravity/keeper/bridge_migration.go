@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// GetBridgeMigration returns the bridge contract migration in progress, or nil
+// if there is none
+func (k Keeper) GetBridgeMigration(ctx sdk.Context) *types.BridgeMigration {
+	bz := k.chainStore(ctx).Get([]byte{types.BridgeMigrationKey})
+	if bz == nil {
+		return nil
+	}
+
+	var migration types.BridgeMigration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return &migration
+}
+
+func (k Keeper) setBridgeMigration(ctx sdk.Context, migration types.BridgeMigration) {
+	k.chainStore(ctx).Set([]byte{types.BridgeMigrationKey}, k.cdc.MustMarshal(&migration))
+}
+
+func (k Keeper) deleteBridgeMigration(ctx sdk.Context) {
+	k.chainStore(ctx).Delete([]byte{types.BridgeMigrationKey})
+}
+
+// checkBridgeNotMigrating returns ErrBridgeMigrationInProgress while a bridge
+// contract migration is in progress, as new outbound traffic is frozen until
+// the bridge has switched to the new contract
+func (k Keeper) checkBridgeNotMigrating(ctx sdk.Context) error {
+	if migration := k.GetBridgeMigration(ctx); migration != nil {
+		return errors.Wrapf(types.ErrBridgeMigrationInProgress, "to %s since height %d", migration.NewBridgeEthereumAddress, migration.Height)
+	}
+	return nil
+}
+
+// CompleteBridgeMigration switches the bridge to the contract of the migration
+// in progress. It must only run once every in-flight batch and contract call
+// has completed or timed out:
+//   - refunds the sends to Ethereum left in the pool
+//   - cleans up the state of the previous contract and resets the bridge nonces
+//   - forgets the ERC20s of Cosmos-originated denoms and the pending deployment
+//     requests, so those denoms must be deployed again on the new contract
+//   - creates a signer set tx for the new contract
+//   - resolves the signer set hijack incidents, lifting the bridge pause
+//   - lifts the outbound traffic freeze
+func (k Keeper) CompleteBridgeMigration(ctx sdk.Context) {
+	migration := k.GetBridgeMigration(ctx)
+	if migration == nil {
+		return
+	}
+	oldBridgeAddress := k.getBridgeContractAddress(ctx)
+
+	var refundedIDs []uint64
+	for _, send := range k.getUnbatchedSendToEthereums(ctx) {
+		// a send that cannot be refunded stays in the pool, to be batched for the new contract
+		xCtx, commit := ctx.CacheContext()
+		if err := k.refundSendToEthereum(xCtx, send); err != nil {
+			k.Logger(ctx).Error("failed to refund send to ethereum during bridge migration", "id", send.Id, "error", err)
+			continue
+		}
+		commit()
+		refundedIDs = append(refundedIDs, send.Id)
+	}
+
+	k.emitTypedEvent(ctx, &types.EventBridgeMigrationDrained{
+		OldBridgeEthereumAddress:  oldBridgeAddress,
+		RefundedSendToEthereumIds: refundedIDs,
+	})
+
+	k.MigrateGravityContract(ctx, migration.NewBridgeEthereumAddress, migration.BridgeDeploymentHeight)
+	k.deleteCosmosOriginatedERC20s(ctx)
+	k.deleteBridgeMigration(ctx)
+	k.CreateSignerSetTx(ctx)
+	k.resolveSignerSetHijackIncidents(ctx)
+
+	k.emitTypedEvent(ctx, &types.EventBridgeMigrationCompleted{
+		OldBridgeEthereumAddress: oldBridgeAddress,
+		NewBridgeEthereumAddress: migration.NewBridgeEthereumAddress,
+		BridgeDeploymentHeight:   migration.BridgeDeploymentHeight,
+	})
+}
+
+// emitTypedEvent emits a typed event, logging the error if it cannot be
+// encoded rather than failing the block
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "event", proto.MessageName(event), "error", err)
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestCompleteBridgeMigration_CosmosOriginatedERC20s(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(gk)
+	var (
+		sender, _     = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		ethAddr       = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		newBridge     = common.HexToAddress("0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7")
+		oldERC20      = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		newERC20      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		testDenom     = "ustake"
+		requestedOnly = "uatom"
+	)
+
+	input.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    testDenom,
+		Display: "stake",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "stake", Exponent: 6},
+		},
+	})
+	require.NoError(t, input.AddBalanceToBank(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10000))))
+	sendToEthereum := func() error {
+		_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+			Sender:            sender.String(),
+			EthereumRecipient: ethAddr.Hex(),
+			Amount:            sdk.NewInt64Coin(testDenom, 1000),
+			BridgeFee:         sdk.NewInt64Coin(testDenom, 10),
+		})
+		return err
+	}
+
+	gk.setCosmosOriginatedDenomToERC20(ctx, testDenom, oldERC20)
+	gk.setERC20DeploymentRequest(ctx, &types.ERC20DeploymentRequest{Denom: requestedOnly, Requester: sender.String()})
+	require.NoError(t, sendToEthereum())
+
+	gk.setBridgeMigration(ctx, types.BridgeMigration{
+		NewBridgeEthereumAddress: newBridge.Hex(),
+		BridgeDeploymentHeight:   100,
+		Height:                   uint64(ctx.BlockHeight()),
+	})
+	gk.CompleteBridgeMigration(ctx)
+
+	// the ERC20 of the old contract is forgotten, along with the pending requests
+	_, found := gk.getCosmosOriginatedERC20(ctx, testDenom)
+	require.False(t, found)
+	isCosmosOriginated, _ := gk.ERC20ToDenomLookup(ctx, oldERC20)
+	require.False(t, isCosmosOriginated)
+	require.Empty(t, gk.getERC20DeploymentRequests(ctx))
+	require.ErrorIs(t, sendToEthereum(), types.ErrERC20NotDeployed)
+
+	// the denom can be sent again once it is deployed on the new contract
+	_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(sender, testDenom))
+	require.NoError(t, err)
+	require.NoError(t, gk.Handle(ctx, &types.ERC20DeployedEvent{
+		EventNonce:     1,
+		CosmosDenom:    testDenom,
+		TokenContract:  newERC20.Hex(),
+		Erc20Name:      "stake",
+		Erc20Symbol:    "stake",
+		Erc20Decimals:  6,
+		EthereumHeight: 101,
+	}))
+	erc20, found := gk.getCosmosOriginatedERC20(ctx, testDenom)
+	require.True(t, found)
+	require.Equal(t, newERC20, erc20)
+	require.NoError(t, sendToEthereum())
+}
@@ -66,6 +66,7 @@ func (k Keeper) contractCallExecuted(ctx sdk.Context, invalidationScope []byte,
 	})
 
 	k.CompleteOutgoingTx(ctx, completedCallTx)
+	k.AfterOutgoingTxCompleted(ctx, completedCallTx)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeContractCallTxCompleted,
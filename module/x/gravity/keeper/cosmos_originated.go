@@ -1,17 +1,19 @@
 package keeper
 
 import (
-	"fmt"
+	"strings"
 
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
 func (k Keeper) getCosmosOriginatedDenom(ctx sdk.Context, tokenContract common.Address) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	bz := store.Get(types.MakeERC20ToDenomKey(tokenContract))
 
 	if bz != nil {
@@ -21,7 +23,7 @@ func (k Keeper) getCosmosOriginatedDenom(ctx sdk.Context, tokenContract common.A
 }
 
 func (k Keeper) getCosmosOriginatedERC20(ctx sdk.Context, denom string) (common.Address, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	bz := store.Get(types.MakeDenomToERC20Key(denom))
 
 	if bz != nil {
@@ -31,7 +33,7 @@ func (k Keeper) getCosmosOriginatedERC20(ctx sdk.Context, denom string) (common.
 }
 
 func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, tokenContract common.Address) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	store.Set(types.MakeDenomToERC20Key(denom), tokenContract.Bytes())
 	store.Set(types.MakeERC20ToDenomKey(tokenContract), []byte(denom))
 }
@@ -43,13 +45,21 @@ func (k Keeper) setCosmosOriginatedDenomToERC20(ctx sdk.Context, denom string, t
 // in an index of ERC20 contracts deployed on Ethereum to serve as synthetic Cosmos assets.
 func (k Keeper) DenomToERC20Lookup(ctx sdk.Context, denom string) (bool, common.Address, error) {
 	// First try parsing the ERC20 out of the denom
-	tc1, err := types.GravityDenomToERC20(denom)
+	tc1, err := k.gravityDenomToERC20(ctx, denom)
 	if err != nil {
 		// Look up ERC20 contract in index and error if it's not in there.
 		tc2, exists := k.getCosmosOriginatedERC20(ctx, denom)
 		if !exists {
-			return false, common.Address{},
-				fmt.Errorf("denom not a gravity voucher coin: %s, and also not in cosmos-originated ERC20 index", err)
+			if _, requested := k.getERC20DeploymentRequest(ctx, denom); requested {
+				return false, common.Address{}, errors.Wrapf(
+					types.ErrERC20NotDeployed,
+					"ERC20 deployment for %s has been requested but not yet observed", denom,
+				)
+			}
+			return false, common.Address{}, errors.Wrapf(
+				types.ErrERC20NotDeployed,
+				"%s is not a gravity voucher and has no cosmos-originated ERC20; request one with MsgRequestERC20Deployment", denom,
+			)
 		}
 		// This is a cosmos-originated asset
 		return true, tc2, nil
@@ -71,18 +81,18 @@ func (k Keeper) ERC20ToDenomLookup(ctx sdk.Context, tokenContract common.Address
 	}
 
 	// If it is not in there, it is not a cosmos originated token, turn the ERC20 into a gravity denom
-	return false, types.GravityDenom(tokenContract)
+	return false, k.gravityDenom(ctx, tokenContract)
 }
 
 // iterateERC20ToDenom iterates over erc20 to denom relations
 func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC20ToDenom) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ERC20ToDenomKey})
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.ERC20ToDenomKey})
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		erc20ToDenom := types.ERC20ToDenom{
-			Erc20: string(iter.Key()),
+			Erc20: common.BytesToAddress(iter.Key()).Hex(),
 			Denom: string(iter.Value()),
 		}
 		// cb returns true to stop early
@@ -91,3 +101,206 @@ func (k Keeper) iterateERC20ToDenom(ctx sdk.Context, cb func([]byte, *types.ERC2
 		}
 	}
 }
+
+// deleteCosmosOriginatedERC20s forgets the ERC20s deployed for Cosmos-originated
+// denoms and the pending deployment requests. Those ERC20s are owned by the
+// bridge contract, so they are deployed again when the bridge moves to a new
+// contract.
+func (k Keeper) deleteCosmosOriginatedERC20s(ctx sdk.Context) {
+	store := k.chainStore(ctx)
+
+	var erc20ToDenoms []*types.ERC20ToDenom
+	k.iterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		erc20ToDenoms = append(erc20ToDenoms, erc20ToDenom)
+		return false
+	})
+	for _, erc20ToDenom := range erc20ToDenoms {
+		store.Delete(types.MakeDenomToERC20Key(erc20ToDenom.Denom))
+		store.Delete(types.MakeERC20ToDenomKey(common.HexToAddress(erc20ToDenom.Erc20)))
+	}
+
+	for _, req := range k.getERC20DeploymentRequests(ctx) {
+		k.deleteERC20DeploymentRequest(ctx, req.Denom)
+	}
+}
+
+func (k Keeper) getERC20DeploymentRequest(ctx sdk.Context, denom string) (*types.ERC20DeploymentRequest, bool) {
+	bz := k.chainStore(ctx).Get(types.MakeERC20DeploymentRequestKey(denom))
+	if bz == nil {
+		return nil, false
+	}
+
+	var req types.ERC20DeploymentRequest
+	k.cdc.MustUnmarshal(bz, &req)
+	return &req, true
+}
+
+func (k Keeper) setERC20DeploymentRequest(ctx sdk.Context, req *types.ERC20DeploymentRequest) {
+	k.chainStore(ctx).Set(types.MakeERC20DeploymentRequestKey(req.Denom), k.cdc.MustMarshal(req))
+}
+
+func (k Keeper) deleteERC20DeploymentRequest(ctx sdk.Context, denom string) {
+	k.chainStore(ctx).Delete(types.MakeERC20DeploymentRequestKey(denom))
+}
+
+// iterateERC20DeploymentRequests iterates over the pending ERC20 deployment requests
+func (k Keeper) iterateERC20DeploymentRequests(ctx sdk.Context, cb func(*types.ERC20DeploymentRequest) bool) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.ERC20DeploymentRequestKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var req types.ERC20DeploymentRequest
+		k.cdc.MustUnmarshal(iter.Value(), &req)
+		// cb returns true to stop early
+		if cb(&req) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getERC20DeploymentRequests(ctx sdk.Context) (out []*types.ERC20DeploymentRequest) {
+	k.iterateERC20DeploymentRequests(ctx, func(req *types.ERC20DeploymentRequest) bool {
+		out = append(out, req)
+		return false
+	})
+	return
+}
+
+func (k Keeper) getIBCDenomMetadata(ctx sdk.Context, denom string) (types.ERC20Metadata, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeIBCDenomMetadataKey(denom))
+	if bz == nil {
+		return types.ERC20Metadata{}, false
+	}
+
+	var md types.ERC20Metadata
+	k.cdc.MustUnmarshal(bz, &md)
+	return md, true
+}
+
+func (k Keeper) setIBCDenomMetadata(ctx sdk.Context, denom string, md types.ERC20Metadata) {
+	ctx.KVStore(k.storeKey).Set(types.MakeIBCDenomMetadataKey(denom), k.cdc.MustMarshal(&md))
+}
+
+// iterateIBCDenomMetadata iterates over the governance-registered ERC20 metadata of IBC denoms
+func (k Keeper) iterateIBCDenomMetadata(ctx sdk.Context, cb func(*types.IBCDenomMetadata) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.IBCDenomMetadataKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		idm := types.IBCDenomMetadata{Denom: string(iter.Key())}
+		k.cdc.MustUnmarshal(iter.Value(), &idm.Metadata)
+		// cb returns true to stop early
+		if cb(&idm) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getIBCDenomMetadatas(ctx sdk.Context) (out []*types.IBCDenomMetadata) {
+	k.iterateIBCDenomMetadata(ctx, func(idm *types.IBCDenomMetadata) bool {
+		out = append(out, idm)
+		return false
+	})
+	return
+}
+
+// hasERC20Metadata returns true if the denom has bank metadata, or ERC20
+// metadata registered by governance for IBC denoms
+func (k Keeper) hasERC20Metadata(ctx sdk.Context, denom string) bool {
+	if _, ok := k.getIBCDenomMetadata(ctx, denom); ok {
+		return true
+	}
+	md, ok := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	return ok && md.Base != ""
+}
+
+// ibcDenomPath resolves an IBC voucher denom to the full path of its denom
+// trace, e.g. ibc/27394F... to transfer/channel-0/uatom. The path is kept so
+// that the same base denom received over different channels, which are
+// different tokens, never resolves the same. It returns false for denoms that
+// are not IBC vouchers or whose trace is unknown.
+func (k Keeper) ibcDenomPath(ctx sdk.Context, denom string) (string, bool) {
+	if k.transferKeeper == nil || !strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return "", false
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return "", false
+	}
+
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", false
+	}
+	return trace.GetFullDenomPath(), true
+}
+
+// expectedERC20DeploymentParams returns the ERC20 name, symbol and decimals
+// that a contract deployed for the Cosmos-originated denom must have. IBC
+// denoms with governance-registered metadata use it as is; denoms with bank
+// metadata use the display unit; denoms without metadata must have supply and
+// get a zero decimal ERC20 with no symbol, named after the path of their
+// IBC denom trace if there is one and after the denom itself otherwise.
+func (k Keeper) expectedERC20DeploymentParams(ctx sdk.Context, denom string) (*types.ERC20DeploymentRequest, error) {
+	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, denom); exists {
+		return nil, errors.Wrapf(
+			types.ErrInvalidERC20Event,
+			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), denom,
+		)
+	}
+
+	// governance-registered metadata overrides everything else
+	if md, ok := k.getIBCDenomMetadata(ctx, denom); ok {
+		return &types.ERC20DeploymentRequest{
+			Denom:         denom,
+			Erc20Name:     md.Name,
+			Erc20Symbol:   md.Symbol,
+			Erc20Decimals: md.Decimals,
+		}, nil
+	}
+
+	// use metadata, if we can find it. ERC20 decimals tell where to display
+	// the decimal point, so they are the exponent of the display unit. Without
+	// a display unit they default to 0, and 1 ATOM would appear on Ethereum as
+	// 1 million ATOM.
+	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, denom); ok && md.Base != "" {
+		var erc20Decimals uint64
+		for _, denomUnit := range md.DenomUnits {
+			if denomUnit.Denom == md.Display {
+				erc20Decimals = uint64(denomUnit.Exponent)
+				break
+			}
+		}
+
+		return &types.ERC20DeploymentRequest{
+			Denom:         md.Base,
+			Erc20Name:     md.Display,
+			Erc20Symbol:   md.Display,
+			Erc20Decimals: erc20Decimals,
+		}, nil
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); supply.IsZero() {
+		return nil, errors.Wrapf(
+			types.ErrInvalidERC20Event,
+			"no supply exists for token %s without metadata", denom,
+		)
+	}
+
+	// no metadata, go with a zero decimal, no symbol erc-20. This path is not
+	// encouraged: IBC denoms should get their metadata registered with an
+	// IBCDenomMetadataProposal.
+	name := denom
+	if path, ok := k.ibcDenomPath(ctx, denom); ok {
+		name = path
+	}
+	return &types.ERC20DeploymentRequest{
+		Denom:         denom,
+		Erc20Name:     name,
+		Erc20Symbol:   "",
+		Erc20Decimals: 0,
+	}, nil
+}
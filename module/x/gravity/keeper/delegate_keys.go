@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// rotateDelegateKeys replaces the ethereum and orchestrator addresses of a
+// validator in the three delegate key indexes. The indexes of the previous
+// addresses are removed so that they can be delegated again.
+func (k Keeper) rotateDelegateKeys(ctx sdk.Context, valAddr sdk.ValAddress, oldEthAddr common.Address, oldOrchAddr sdk.AccAddress, ethAddr common.Address, orchAddr sdk.AccAddress) {
+	k.deleteEthereumOrchestratorAddress(ctx, oldEthAddr)
+	k.deleteOrchestratorValidatorAddress(ctx, oldOrchAddr)
+	// an additional orchestrator promoted to the delegate keys leaves the index
+	k.deleteValidatorOrchestrator(ctx, valAddr, orchAddr)
+
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+	k.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+	k.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
+
+	height := uint64(ctx.BlockHeight())
+	k.setDelegateKeysRotationHeight(ctx, valAddr, height)
+	if ethAddr != oldEthAddr {
+		k.setLastEthereumKeyRotationHeight(ctx, height)
+	}
+}
+
+// validatorAccountNonce returns the sequence the validator account had before
+// the current tx. We decrement since we process the message after the
+// ante-handler which increments the sequence.
+func (k Keeper) validatorAccountNonce(ctx sdk.Context, valAddr sdk.ValAddress) (uint64, error) {
+	seq, err := k.accountKeeper.GetSequence(ctx, sdk.AccAddress(valAddr))
+	if err != nil {
+		return 0, err
+	}
+	if seq > 0 {
+		return seq - 1, nil
+	}
+	return 0, nil
+}
+
+func (k Keeper) setDelegateKeysRotationHeight(ctx sdk.Context, valAddr sdk.ValAddress, height uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeDelegateKeysRotationHeightKey(valAddr), sdk.Uint64ToBigEndian(height))
+}
+
+// GetDelegateKeysRotationHeight returns the height at which the validator last
+// rotated its delegate keys, or 0 if it never did
+func (k Keeper) GetDelegateKeysRotationHeight(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeDelegateKeysRotationHeightKey(valAddr))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// iterateDelegateKeysRotationHeights iterates through the height at which each
+// validator last rotated its delegate keys
+func (k Keeper) iterateDelegateKeysRotationHeights(ctx sdk.Context, cb func(sdk.ValAddress, uint64) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DelegateKeysRotationHeightKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setLastEthereumKeyRotationHeight(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.LastEthereumKeyRotationHeightKey}, sdk.Uint64ToBigEndian(height))
+}
+
+// GetLastEthereumKeyRotationHeight returns the height at which a validator last
+// rotated its ethereum key, or 0 if none did
+func (k Keeper) GetLastEthereumKeyRotationHeight(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastEthereumKeyRotationHeightKey})
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setAdditionalOrchestrator registers an orchestrator account of a validator
+// on top of the one set with its delegate keys
+func (k Keeper) setAdditionalOrchestrator(ctx sdk.Context, valAddr sdk.ValAddress, orchAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.MakeValidatorOrchestratorKey(valAddr, orchAddr), orchAddr.Bytes())
+	k.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+}
+
+// removeAdditionalOrchestrator unregisters an additional orchestrator account,
+// which can no longer sign on behalf of the validator
+func (k Keeper) removeAdditionalOrchestrator(ctx sdk.Context, valAddr sdk.ValAddress, orchAddr sdk.AccAddress) {
+	k.deleteValidatorOrchestrator(ctx, valAddr, orchAddr)
+	k.deleteOrchestratorValidatorAddress(ctx, orchAddr)
+}
+
+func (k Keeper) deleteValidatorOrchestrator(ctx sdk.Context, valAddr sdk.ValAddress, orchAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeValidatorOrchestratorKey(valAddr, orchAddr))
+}
+
+func (k Keeper) hasAdditionalOrchestrator(ctx sdk.Context, valAddr sdk.ValAddress, orchAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeValidatorOrchestratorKey(valAddr, orchAddr))
+}
+
+// GetAdditionalOrchestrators returns the orchestrator accounts a validator
+// registered with MsgAddOrchestrator
+func (k Keeper) GetAdditionalOrchestrators(ctx sdk.Context, valAddr sdk.ValAddress) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeValidatorOrchestratorKey(valAddr, nil))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var orchestrators []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		orchestrators = append(orchestrators, sdk.AccAddress(iter.Value()))
+	}
+	return orchestrators
+}
+
+// getAdditionalOrchestrators returns the additional orchestrator accounts of
+// every validator
+func (k Keeper) getAdditionalOrchestrators(ctx sdk.Context) []*types.MsgAddOrchestrator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ValidatorOrchestratorKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var orchestrators []*types.MsgAddOrchestrator
+	for ; iter.Valid(); iter.Next() {
+		orchAddr := sdk.AccAddress(iter.Value())
+		valAddr := sdk.ValAddress(iter.Key()[:len(iter.Key())-len(orchAddr)])
+		orchestrators = append(orchestrators, types.NewMsgAddOrchestrator(valAddr, orchAddr))
+	}
+	return orchestrators
+}
+
+// orchestratorInUse returns true if the account is the orchestrator of the
+// delegate keys of a validator, or an additional orchestrator of one
+func (k Keeper) orchestratorInUse(ctx sdk.Context, orchAddr sdk.AccAddress) bool {
+	if k.ethAddressForOrchestratorExists(ctx, orchAddr) {
+		return true
+	}
+	valAddr := k.GetOrchestratorValidatorAddress(ctx, orchAddr)
+	return valAddr != nil && k.hasAdditionalOrchestrator(ctx, valAddr, orchAddr)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// setERC20MetadataVote records the metadata of the token reported by the
+// validator, replacing its previous vote
+func (k Keeper) setERC20MetadataVote(ctx sdk.Context, contract common.Address, val sdk.ValAddress, metadata types.ERC20Metadata) {
+	k.chainStore(ctx).Set(types.MakeERC20MetadataVoteKey(contract, val), k.cdc.MustMarshal(&metadata))
+}
+
+// iterateERC20MetadataVotes iterates over the ERC20 metadata votes of every
+// token contract
+func (k Keeper) iterateERC20MetadataVotes(ctx sdk.Context, cb func(contract common.Address, val sdk.ValAddress, metadata types.ERC20Metadata) (stop bool)) {
+	k.iterateERC20MetadataVotesByPrefix(ctx, []byte{types.ERC20MetadataVoteKey}, cb)
+}
+
+// iterateERC20MetadataVotesByPrefix iterates over the ERC20 metadata votes
+// with keys starting with the prefix
+func (k Keeper) iterateERC20MetadataVotesByPrefix(ctx sdk.Context, keyPrefix []byte, cb func(contract common.Address, val sdk.ValAddress, metadata types.ERC20Metadata) (stop bool)) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := bytes.NewBuffer(append(append([]byte{}, keyPrefix[1:]...), iter.Key()...))
+		contract := common.BytesToAddress(key.Next(common.AddressLength))
+		val := sdk.ValAddress(key.Bytes())
+
+		var metadata types.ERC20Metadata
+		k.cdc.MustUnmarshal(iter.Value(), &metadata)
+		// cb returns true to stop early
+		if cb(contract, val, metadata) {
+			break
+		}
+	}
+}
+
+// deleteERC20MetadataVotes deletes the ERC20 metadata votes of the token
+func (k Keeper) deleteERC20MetadataVotes(ctx sdk.Context, contract common.Address) {
+	store := k.chainStore(ctx)
+	var keys [][]byte
+	k.iterateERC20MetadataVotesByPrefix(ctx, makeERC20MetadataVotesPrefix(contract), func(contract common.Address, val sdk.ValAddress, _ types.ERC20Metadata) bool {
+		keys = append(keys, types.MakeERC20MetadataVoteKey(contract, val))
+		return false
+	})
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// tallyERC20MetadataVotes registers the voucher metadata of the token once
+// validators with enough power report the same metadata for it, and then
+// deletes its votes
+func (k Keeper) tallyERC20MetadataVotes(ctx sdk.Context, contract common.Address) {
+	requiredPower := types.EventVoteRecordPowerThreshold(k.StakingKeeper.GetLastTotalPower(ctx))
+
+	var (
+		powers   = make(map[types.ERC20Metadata]sdk.Int)
+		accepted *types.ERC20Metadata
+	)
+	k.iterateERC20MetadataVotesByPrefix(ctx, makeERC20MetadataVotesPrefix(contract), func(_ common.Address, val sdk.ValAddress, metadata types.ERC20Metadata) bool {
+		power, ok := powers[metadata]
+		if !ok {
+			power = sdk.ZeroInt()
+		}
+		power = power.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		powers[metadata] = power
+
+		if power.GTE(requiredPower) {
+			accepted = &metadata
+			return true
+		}
+		return false
+	})
+	if accepted == nil {
+		return
+	}
+
+	k.registerVoucherMetadata(ctx, k.gravityDenom(ctx, contract), *accepted)
+	k.deleteERC20MetadataVotes(ctx, contract)
+}
+
+// registerVoucherMetadata sets bank metadata for the vouchers of an
+// Ethereum-originated token once validators agree on its ERC20 metadata.
+// Votes never replace existing metadata, which can only be changed through an
+// ERC20MetadataProposal. The symbol is chosen by whoever deployed the token, so
+// it is only used as the display unit when it is a valid denom that no coin of
+// the chain uses. Metadata that the bank module would reject is logged and
+// skipped.
+func (k Keeper) registerVoucherMetadata(ctx sdk.Context, denom string, erc20Metadata types.ERC20Metadata) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return
+	}
+
+	md := erc20Metadata.BankMetadata(denom)
+	if md.Display != denom && (sdk.ValidateDenom(md.Display) != nil || k.isDenomInUse(ctx, md.Display)) {
+		k.Logger(ctx).Info("skipping voucher display unit", "denom", denom, "symbol", md.Display)
+		md.DenomUnits, md.Display = md.DenomUnits[:1], denom
+	}
+	if err := md.Validate(); err != nil {
+		k.Logger(ctx).Info("skipping invalid voucher metadata", "denom", denom, "error", err)
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+}
+
+// isDenomInUse returns true if coins of the denom exist or the denom has bank
+// metadata
+func (k Keeper) isDenomInUse(ctx sdk.Context, denom string) bool {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return true
+	}
+	return k.bankKeeper.GetSupply(ctx, denom).IsPositive()
+}
+
+func (k Keeper) getERC20MetadataVotes(ctx sdk.Context) (out []*types.ERC20MetadataVote) {
+	k.iterateERC20MetadataVotes(ctx, func(contract common.Address, val sdk.ValAddress, metadata types.ERC20Metadata) bool {
+		out = append(out, &types.ERC20MetadataVote{
+			ValidatorAddress: val.String(),
+			TokenContract:    contract.Hex(),
+			Metadata:         metadata,
+		})
+		return false
+	})
+	return
+}
+
+// makeERC20MetadataVotesPrefix returns the prefix of the ERC20 metadata votes
+// of the token
+func makeERC20MetadataVotesPrefix(contract common.Address) []byte {
+	return append([]byte{types.ERC20MetadataVoteKey}, contract.Bytes()...)
+}
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"math/big"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
@@ -25,9 +27,26 @@ func (k Keeper) DetectMaliciousSupply(ctx sdk.Context, denom string, amount sdk.
 func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	switch event := eve.(type) {
 	case *types.SendToCosmosEvent:
+		// hooks may reject the deposit or redirect it by changing the receiver,
+		// so work on a copy of the event and validate it again afterwards. Any
+		// other change is rejected, as the coins minted or unlocked must match
+		// what was deposited on Ethereum.
+		deposit := *event
+		if err := k.BeforeSendToCosmos(ctx, &deposit); err != nil {
+			return err
+		}
+		observed := *event
+		observed.CosmosReceiver = deposit.CosmosReceiver
+		if !bytes.Equal(k.cdc.MustMarshal(&observed), k.cdc.MustMarshal(&deposit)) {
+			return errors.Wrap(types.ErrInvalid, "deposit changed by hooks beyond its receiver")
+		}
+		if err := deposit.Validate(); err != nil {
+			return errors.Wrap(err, "deposit changed by hooks")
+		}
+		event = &deposit
+
 		// Check if coin is Cosmos-originated asset and get denom
 		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(event.TokenContract))
-		addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
 		coins := sdk.Coins{sdk.NewCoin(denom, event.Amount)}
 
 		if !isCosmosOriginated {
@@ -46,7 +65,12 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 				return err
 			}
 		} else {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			addr, _ := sdk.AccAddressFromBech32(event.CosmosReceiver)
+			if forward, ok := k.getIBCForward(ctx, addr); ok {
+				if err := k.forwardFromModule(ctx, forward, coins[0]); err != nil {
+					return err
+				}
+			} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
 				return err
 			}
 		}
@@ -63,8 +87,9 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 			return err
 		}
 
-		// add to denom-erc20 mapping
+		// add to denom-erc20 mapping, settling any pending deployment request
 		k.setCosmosOriginatedDenomToERC20(ctx, event.CosmosDenom, common.HexToAddress(event.TokenContract))
+		k.deleteERC20DeploymentRequest(ctx, event.CosmosDenom)
 		k.AfterERC20DeployedEvent(ctx, *event)
 		return nil
 
@@ -74,7 +99,11 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 		return nil
 
 	case *types.SignerSetTxExecutedEvent:
-		k.SignerSetExecuted(ctx, event.GetEventNonce())
+		// a hijacked signer set is recorded as an incident rather than executed
+		if k.checkSignerSetHijack(ctx, event) {
+			return nil
+		}
+		k.SignerSetExecuted(ctx, event.SignerSetTxNonce)
 		k.AfterSignerSetExecutedEvent(ctx, *event)
 		return nil
 
@@ -83,105 +112,91 @@ func (k Keeper) Handle(ctx sdk.Context, eve types.EthereumEvent) (err error) {
 	}
 }
 
-func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
-	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, event.CosmosDenom); exists {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), event.CosmosDenom,
-		)
+// forwardFromModule credits coins held by the module, such as bridged coins or
+// refunds, to the forward's fallback account and then attempts to send them on
+// over IBC from that account. If the transfer cannot be initiated the coins
+// stay with the fallback account, and the failure is emitted as an event rather
+// than failing the whole deposit or refund. Refunds of transfers that later
+// fail or time out are also returned to the fallback account by the transfer
+// module.
+func (k Keeper) forwardFromModule(ctx sdk.Context, forward types.IBCForward, coin sdk.Coin) error {
+	fallback, err := forward.FallbackAddress()
+	if err != nil {
+		return err
 	}
 
-	// We expect that all Cosmos-based tokens have metadata defined. In the case
-	// a token does not have metadata defined, e.g. an IBC token, we successfully
-	// handle the token under the following conditions:
-	//
-	// 1. The ERC20 name is equal to the token's denomination. Otherwise, this
-	// 		means that ERC20 tokens would have an untenable UX.
-	// 2. The ERC20 token has zero decimals as this is what we default to since
-	// 		we cannot know or infer the real decimal value for the Cosmos token.
-	// 3. The ERC20 symbol is empty.
-	//
-	// NOTE: This path is not encouraged and all supported assets should have
-	// metadata defined. If metadata cannot be defined, consider adding the token's
-	// metadata on the fly.
-	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, event.CosmosDenom); ok && md.Base != "" {
-		return verifyERC20Token(md, event)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fallback, sdk.NewCoins(coin)); err != nil {
+		return err
 	}
 
-	if supply := k.bankKeeper.GetSupply(ctx, event.CosmosDenom); supply.IsZero() {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"no supply exists for token %s without metadata", event.CosmosDenom,
-		)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardReceiver, forward.Receiver),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardChannel, forward.Channel),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardFallback, fallback.String()),
+		sdk.NewAttribute(types.AttributeKeyIBCForwardAmount, coin.String()),
 	}
 
-	if event.Erc20Name != event.CosmosDenom {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"invalid ERC20 name for token without metadata; got: %s, expected: %s", event.Erc20Name, event.CosmosDenom,
-		)
-	}
-
-	if event.Erc20Symbol != "" {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"expected empty ERC20 symbol for token without metadata; got: %s", event.Erc20Symbol,
-		)
-	}
-
-	if event.Erc20Decimals != 0 {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"expected zero ERC20 decimals for token without metadata; got: %d", event.Erc20Decimals,
+	if err := k.ibcTransfer(ctx, forward, fallback, coin); err != nil {
+		k.Logger(ctx).Info(
+			"ibc forward failed, coins left with fallback account",
+			"cause", err.Error(),
+			"receiver", forward.Receiver,
+			"channel", forward.Channel,
+			"fallback", fallback.String(),
 		)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeIBCForwardFailed,
+			append(attributes, sdk.NewAttribute(types.AttributeKeyIBCForwardError, err.Error()))...,
+		))
+		return nil
 	}
 
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCForward, attributes...))
 	return nil
 }
 
-func verifyERC20Token(metadata banktypes.Metadata, event *types.ERC20DeployedEvent) error {
-	if event.Erc20Name != metadata.Display {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"ERC20 name %s does not match the denom display %s", event.Erc20Name, metadata.Display,
-		)
+// ibcTransfer sends the coin from the sender over the forward's channel,
+// discarding all state changes if the transfer fails
+func (k Keeper) ibcTransfer(ctx sdk.Context, forward types.IBCForward, sender sdk.AccAddress, coin sdk.Coin) error {
+	if k.transferKeeper == nil {
+		return errors.Wrap(types.ErrInvalid, "ibc transfer keeper not set")
 	}
 
-	if event.Erc20Symbol != metadata.Display {
-		return errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"ERC20 symbol %s does not match denom display %s", event.Erc20Symbol, metadata.Display,
-		)
+	xCtx, commit := ctx.CacheContext()
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		forward.Channel,
+		coin,
+		sender.String(),
+		forward.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.IBCForwardTimeout).UnixNano()),
+		forward.Memo,
+	)
+	if _, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(xCtx), msg); err != nil {
+		return err
 	}
 
-	// ERC20 tokens use a very simple mechanism to tell you where to display the
-	// decimal point. The "decimals" field simply tells you how many decimal places
-	// there will be.
-	//
-	// Cosmos denoms have a system that is much more full featured, with
-	// enterprise-ready token denominations. There is a DenomUnits array that
-	// tells you what the name of each denomination of the token is.
-	//
-	// To correlate this with an ERC20 "decimals" field, we have to search through
-	// the DenomUnits array to find the DenomUnit which matches up to the main
-	// token "display" value. Then we take the "exponent" from this DenomUnit.
-	//
-	// If the correct DenomUnit is not found, it will default to 0. This will
-	// result in there being no decimal places in the token's ERC20 on Ethereum.
-	// For example, if this happened with ATOM, 1 ATOM would appear on Ethereum
-	// as 1 million ATOM, having 6 extra places before the decimal point.
-	var decimals uint32
-	for _, denomUnit := range metadata.DenomUnits {
-		if denomUnit.Denom == metadata.Display {
-			decimals = denomUnit.Exponent
-			break
-		}
+	commit()
+	return nil
+}
+
+// verifyERC20DeployedEvent checks that the deployed ERC20 has the name, symbol
+// and decimals expected for its denom, the same that MsgRequestERC20Deployment
+// records in a deployment request
+func (k Keeper) verifyERC20DeployedEvent(ctx sdk.Context, event *types.ERC20DeployedEvent) error {
+	expected, err := k.expectedERC20DeploymentParams(ctx, event.CosmosDenom)
+	if err != nil {
+		return err
 	}
 
-	if uint64(decimals) != event.Erc20Decimals {
+	if event.Erc20Name != expected.Erc20Name || event.Erc20Symbol != expected.Erc20Symbol || event.Erc20Decimals != expected.Erc20Decimals {
 		return errors.Wrapf(
 			types.ErrInvalidERC20Event,
-			"ERC20 decimals %d does not match denom decimals %d", event.Erc20Decimals, decimals,
+			"ERC20 %s (%s, %d decimals) does not match the expected %s (%s, %d decimals) of denom %s",
+			event.Erc20Name, event.Erc20Symbol, event.Erc20Decimals,
+			expected.Erc20Name, expected.Erc20Symbol, expected.Erc20Decimals, event.CosmosDenom,
 		)
 	}
 
@@ -1,11 +1,23 @@
 package keeper
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"math/big"
 	"testing"
 
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/contracts"
+	"github.com/peggyjv/gravity-bridge/module/v6/orchestrator"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
 func TestDetectMaliciousSupply(t *testing.T) {
@@ -19,3 +31,111 @@ func TestDetectMaliciousSupply(t *testing.T) {
 	err := input.GravityKeeper.DetectMaliciousSupply(input.Context, "stake", bigCoinAmount)
 	require.Error(t, err, "didn't error out on too much added supply")
 }
+
+type mockTransferKeeper struct {
+	bankKeeper types.BankKeeper
+	msgs       []*ibctransfertypes.MsgTransfer
+	err        error
+	traces     []ibctransfertypes.DenomTrace
+}
+
+func (m *mockTransferKeeper) GetDenomTrace(_ sdktypes.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool) {
+	for _, trace := range m.traces {
+		if bytes.Equal(trace.Hash(), denomTraceHash) {
+			return trace, true
+		}
+	}
+	return ibctransfertypes.DenomTrace{}, false
+}
+
+func (m *mockTransferKeeper) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+	sender, err := sdktypes.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// escrow the coins in the gravity module account as a stand in for the transfer escrow
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdktypes.NewCoins(msg.Token)); err != nil {
+		return nil, err
+	}
+	m.msgs = append(m.msgs, msg)
+	return &ibctransfertypes.MsgTransferResponse{Sequence: uint64(len(m.msgs))}, nil
+}
+
+// sendToCosmosLog returns the log Gravity.sol emits for a deposit to the
+// cosmos account, whose address is left padded to 32 bytes
+func sendToCosmosLog(t *testing.T, token, sender common.Address, receiver sdktypes.AccAddress, amount int64, nonce uint64) gethtypes.Log {
+	ev := contracts.GravityABI.Events[contracts.SendToCosmosEventName]
+	data, err := ev.Inputs.NonIndexed().Pack(big.NewInt(amount), new(big.Int).SetUint64(nonce))
+	require.NoError(t, err)
+	return gethtypes.Log{
+		Topics:      []common.Hash{ev.ID, common.BytesToHash(token.Bytes()), common.BytesToHash(sender.Bytes()), common.BytesToHash(receiver)},
+		Data:        data,
+		BlockNumber: 10,
+	}
+}
+
+func TestHandleSendToCosmosIBCForward(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	msgServer := NewMsgServerImpl(input.GravityKeeper)
+
+	transferKeeper := &mockTransferKeeper{bankKeeper: input.BankKeeper}
+	input.GravityKeeper.SetTransferKeeper(transferKeeper)
+
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", AccAddrs[1])
+	require.NoError(t, err)
+	token := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.GravityDenom(token)
+
+	// deposit from Ethereum to the account of a registered forward, observed
+	// by the orchestrator like any other deposit
+	deposit := func(forward types.IBCForward, nonce uint64) {
+		res, err := msgServer.RegisterIBCForward(sdktypes.WrapSDKContext(ctx), types.NewMsgRegisterIBCForward(AccAddrs[0], forward))
+		require.NoError(t, err)
+		require.Equal(t, types.IBCForwardAccount(forward).String(), res.Account)
+
+		account, err := sdktypes.AccAddressFromBech32(res.Account)
+		require.NoError(t, err)
+		event, err := orchestrator.ParseEvent(sendToCosmosLog(t, token, EthAddrs[0], account, 100, nonce))
+		require.NoError(t, err)
+		require.NoError(t, event.Validate())
+		require.NoError(t, input.GravityKeeper.Handle(ctx, event))
+		require.True(t, input.BankKeeper.GetAllBalances(ctx, account).IsZero())
+	}
+
+	// successful forward sends the coins on from the derived fallback account
+	deposit(types.IBCForward{Receiver: osmoAddr, Channel: "channel-0"}, 1)
+	require.Len(t, transferKeeper.msgs, 1)
+	msg := transferKeeper.msgs[0]
+	require.Equal(t, "channel-0", msg.SourceChannel)
+	require.Equal(t, ibctransfertypes.PortID, msg.SourcePort)
+	require.Equal(t, osmoAddr, msg.Receiver)
+	require.Equal(t, AccAddrs[1].String(), msg.Sender)
+	require.Equal(t, sdktypes.NewInt64Coin(denom, 100), msg.Token)
+	require.Equal(t, uint64(ctx.BlockTime().Add(types.IBCForwardTimeout).UnixNano()), msg.TimeoutTimestamp)
+	require.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).IsZero())
+
+	// failed forward leaves the coins with the explicit fallback account
+	transferKeeper.err = errors.New("channel closed")
+	deposit(types.IBCForward{Receiver: osmoAddr, Channel: "channel-0", Fallback: AccAddrs[2].String()}, 2)
+	require.Len(t, transferKeeper.msgs, 1)
+	require.Equal(t, sdktypes.NewInt64Coin(denom, 100), input.BankKeeper.GetBalance(ctx, AccAddrs[2], denom))
+
+	var failed bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeIBCForwardFailed {
+			failed = true
+		}
+	}
+	require.True(t, failed)
+
+	// deposits to other accounts are not forwarded
+	event, err := orchestrator.ParseEvent(sendToCosmosLog(t, token, EthAddrs[0], AccAddrs[3], 100, 3))
+	require.NoError(t, err)
+	require.NoError(t, input.GravityKeeper.Handle(ctx, event))
+	require.Equal(t, sdktypes.NewInt64Coin(denom, 100), input.BankKeeper.GetBalance(ctx, AccAddrs[3], denom))
+}
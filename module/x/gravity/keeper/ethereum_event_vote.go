@@ -142,16 +142,16 @@ func (k Keeper) processEthereumEvent(ctx sdk.Context, event types.EthereumEvent)
 
 // setEthereumEventVoteRecord sets the attestation in the store
 func (k Keeper) setEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte, eventVoteRecord *types.EthereumEventVoteRecord) {
-	ctx.KVStore(k.storeKey).Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
+	k.chainStore(ctx).Set(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash), k.cdc.MustMarshal(eventVoteRecord))
 }
 
 func (k Keeper) DeleteEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash))
+	k.chainStore(ctx).Delete(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash))
 }
 
 // GetEthereumEventVoteRecord return a vote record given a nonce
 func (k Keeper) GetEthereumEventVoteRecord(ctx sdk.Context, eventNonce uint64, claimHash []byte) *types.EthereumEventVoteRecord {
-	if bz := ctx.KVStore(k.storeKey).Get(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash)); bz == nil {
+	if bz := k.chainStore(ctx).Get(types.MakeEthereumEventVoteRecordKey(eventNonce, claimHash)); bz == nil {
 		return nil
 	} else {
 		var out types.EthereumEventVoteRecord
@@ -180,7 +180,7 @@ func (k Keeper) GetEthereumEventVoteRecordMapping(ctx sdk.Context) (out map[uint
 
 // iterateEthereumEventVoteRecords iterates through all attestations
 func (k Keeper) IterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte, *types.EthereumEventVoteRecord) bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	store := prefix.NewStore(k.chainStore(ctx), []byte{types.EthereumEventVoteRecordKey})
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -194,7 +194,7 @@ func (k Keeper) IterateEthereumEventVoteRecords(ctx sdk.Context, cb func([]byte,
 }
 
 func (k Keeper) PaginateEthereumEventVoteRecords(ctx sdk.Context, pageReq *query.PageRequest, cb func(key []byte, record *types.EthereumEventVoteRecord) bool) (*query.PageResponse, error) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.EthereumEventVoteRecordKey})
 
 	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if !accumulate {
@@ -213,7 +213,7 @@ func (k Keeper) PaginateEthereumEventVoteRecords(ctx sdk.Context, pageReq *query
 
 // GetLastObservedEventNonce returns the latest observed event nonce
 func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	bytes := store.Get([]byte{types.LastObservedEventNonceKey})
 
 	if len(bytes) == 0 {
@@ -225,7 +225,7 @@ func (k Keeper) GetLastObservedEventNonce(ctx sdk.Context) uint64 {
 // GetLastObservedEthereumBlockHeight height gets the block height to of the last observed attestation from
 // the store
 func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LatestEthereumBlockHeight {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	bytes := store.Get([]byte{types.LastEthereumBlockHeightKey})
 
 	if len(bytes) == 0 {
@@ -246,7 +246,7 @@ func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeig
 
 // SetLastObservedEthereumBlockHeight sets the block height in the store, specifying the cosmos height
 func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, ethereumHeight uint64, cosmosHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	height := types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   cosmosHeight,
@@ -256,13 +256,13 @@ func (k Keeper) SetLastObservedEthereumBlockHeightWithCosmos(ctx sdk.Context, et
 
 // setLastObservedEventNonce sets the latest observed event nonce
 func (k Keeper) setLastObservedEventNonce(ctx sdk.Context, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	store.Set([]byte{types.LastObservedEventNonceKey}, sdk.Uint64ToBigEndian(nonce))
 }
 
 // getLastEventNonceByValidator returns the latest event nonce for a given validator
 func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	bytes := store.Get(types.MakeLastEventNonceByValidatorKey(validator))
 
 	if len(bytes) == 0 {
@@ -308,6 +308,17 @@ func (k Keeper) getLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 
 // setLastEventNonceByValidator sets the latest event nonce for a give validator
 func (k Keeper) setLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	store.Set(types.MakeLastEventNonceByValidatorKey(validator), sdk.Uint64ToBigEndian(nonce))
 }
+
+// iterateLastEventNonceByValidator iterates through the latest event nonce of each validator that voted on an event
+func (k Keeper) iterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	iter := prefix.NewStore(k.chainStore(ctx), []byte{types.LastEventNonceByValidatorKey}).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.ValAddress(iter.Key()), binary.BigEndian.Uint64(iter.Value())) {
+			break
+		}
+	}
+}
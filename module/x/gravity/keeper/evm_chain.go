@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// ForEVMChain returns a copy of the keeper scoped to the state of an EVM chain,
+// identified by the bridge chain id of its params. Chain id 0 selects the
+// default chain. The delegate keys are shared by all chains.
+func (k Keeper) ForEVMChain(evmChainID uint64) Keeper {
+	k.evmChainID = evmChainID
+	return k
+}
+
+// EVMChainID returns the id of the EVM chain the keeper is scoped to. Until
+// the default chain is registered, it is the bridge chain id of the params.
+func (k Keeper) EVMChainID(ctx sdk.Context) uint64 {
+	if k.evmChainID != 0 {
+		return k.evmChainID
+	}
+	if !ctx.KVStore(k.storeKey).Has([]byte{types.DefaultEVMChainIDKey}) {
+		return k.GetParams(ctx).BridgeChainId
+	}
+	return k.GetDefaultEVMChainID(ctx)
+}
+
+// chainStore returns the store of the EVM chain the keeper is scoped to. Until
+// the default chain is registered, by InitGenesis or the store migration, the
+// state of the single chain is stored without prefix.
+func (k Keeper) chainStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	if k.evmChainID == 0 && !store.Has([]byte{types.DefaultEVMChainIDKey}) {
+		return store
+	}
+	return prefix.NewStore(store, types.MakeEVMChainStoreKey(k.EVMChainID(ctx)))
+}
+
+// isDefaultEVMChain returns true if the keeper is scoped to the default chain
+func (k Keeper) isDefaultEVMChain(ctx sdk.Context) bool {
+	return k.evmChainID == 0 || k.evmChainID == k.GetDefaultEVMChainID(ctx)
+}
+
+// GetDefaultEVMChainID returns the id of the default EVM chain, whose state
+// messages and queries select with chain id 0
+func (k Keeper) GetDefaultEVMChainID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte{types.DefaultEVMChainIDKey})
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setDefaultEVMChain registers the default EVM chain
+func (k Keeper) setDefaultEVMChain(ctx sdk.Context, evmChainID uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte{types.DefaultEVMChainIDKey}, sdk.Uint64ToBigEndian(evmChainID))
+	k.setEVMChain(ctx, evmChainID)
+}
+
+func (k Keeper) setEVMChain(ctx sdk.Context, evmChainID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MakeEVMChainKey(evmChainID), []byte{1})
+}
+
+// HasEVMChain returns true if the EVM chain is registered
+func (k Keeper) HasEVMChain(ctx sdk.Context, evmChainID uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MakeEVMChainKey(evmChainID))
+}
+
+// GetEVMChainIDs returns the ids of the registered EVM chains in ascending order
+func (k Keeper) GetEVMChainIDs(ctx sdk.Context) (out []uint64) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EVMChainKey}).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		out = append(out, binary.BigEndian.Uint64(iter.Key()))
+	}
+	return out
+}
+
+// EVMChainKeepers returns the keeper scoped to each registered EVM chain, the
+// default chain first. Before the default chain is registered, it only returns
+// the unscoped keeper.
+func (k Keeper) EVMChainKeepers(ctx sdk.Context) []Keeper {
+	defaultChainID := k.GetDefaultEVMChainID(ctx)
+	out := []Keeper{k.ForEVMChain(0)}
+	for _, evmChainID := range k.GetEVMChainIDs(ctx) {
+		if evmChainID != defaultChainID {
+			out = append(out, k.ForEVMChain(evmChainID))
+		}
+	}
+	return out
+}
+
+// evmChainKeeper returns the keeper scoped to the EVM chain selected by a
+// message or query, chain id 0 selecting the default chain
+func (k Keeper) evmChainKeeper(ctx sdk.Context, evmChainID uint64) (Keeper, error) {
+	if evmChainID != 0 && !k.HasEVMChain(ctx, evmChainID) {
+		return k, errors.Wrapf(types.ErrUnknownEVMChain, "%d", evmChainID)
+	}
+	return k.ForEVMChain(evmChainID), nil
+}
+
+// addEVMChain registers an additional EVM chain with its params. Each chain
+// needs its own gravity id, as it is part of every signed checkpoint and keeps
+// the signatures of one chain from being replayed on another.
+func (k Keeper) addEVMChain(ctx sdk.Context, params types.Params) error {
+	evmChainID := params.BridgeChainId
+	if k.HasEVMChain(ctx, evmChainID) {
+		return errors.Wrapf(types.ErrInvalid, "evm chain %d is already registered", evmChainID)
+	}
+	if err := k.checkGravityIDUnused(ctx, evmChainID, params.GravityId); err != nil {
+		return err
+	}
+
+	k.setEVMChain(ctx, evmChainID)
+	k.ForEVMChain(evmChainID).SetParams(ctx, params)
+
+	return nil
+}
+
+// checkGravityIDUnused returns an error if an EVM chain other than the given
+// one uses the gravity id
+func (k Keeper) checkGravityIDUnused(ctx sdk.Context, evmChainID uint64, gravityID string) error {
+	for _, ck := range k.EVMChainKeepers(ctx) {
+		if ck.EVMChainID(ctx) != evmChainID && ck.getGravityID(ctx) == gravityID {
+			return errors.Wrapf(types.ErrInvalid, "gravity id %s is used by evm chain %d", gravityID, ck.EVMChainID(ctx))
+		}
+	}
+	return nil
+}
+
+// gravityDenom returns the voucher denom of an ERC20 of the EVM chain. The
+// default chain keeps the gravity0x... denoms of the single chain bridge.
+func (k Keeper) gravityDenom(ctx sdk.Context, contract common.Address) string {
+	if k.isDefaultEVMChain(ctx) {
+		return types.GravityDenom(contract)
+	}
+	return types.EVMChainGravityDenom(k.EVMChainID(ctx), contract)
+}
+
+// gravityDenomToERC20 returns the ERC20 of a voucher denom of the EVM chain,
+// or an error if the denom is not a voucher of this chain
+func (k Keeper) gravityDenomToERC20(ctx sdk.Context, denom string) (string, error) {
+	if k.isDefaultEVMChain(ctx) {
+		return types.GravityDenomToERC20(denom)
+	}
+
+	evmChainID, contract, err := types.EVMChainGravityDenomToERC20(denom)
+	if err != nil {
+		return "", err
+	}
+	if evmChainID != k.EVMChainID(ctx) {
+		return "", errors.Wrapf(types.ErrInvalid, "denom %s is a voucher of evm chain %d", denom, evmChainID)
+	}
+	return contract, nil
+}
+
+// isGravityDenom returns true if the denom is a voucher of any EVM chain
+func isGravityDenom(denom string) bool {
+	if _, err := types.GravityDenomToERC20(denom); err == nil {
+		return true
+	}
+	_, _, err := types.EVMChainGravityDenomToERC20(denom)
+	return err == nil
+}
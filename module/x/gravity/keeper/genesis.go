@@ -12,6 +12,74 @@ import (
 
 // InitGenesis starts a chain from a genesis state
 func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
+	// reset delegate keys in state
+	for _, keys := range data.DelegateKeys {
+		if err := keys.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("Invalid delegate key in Genesis: %s", err))
+		}
+
+		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
+		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
+		eth := common.HexToAddress(keys.EthereumAddress)
+
+		// set the orchestrator address
+		k.SetOrchestratorValidatorAddress(ctx, val, orch)
+		// set the ethereum address
+		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
+		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+	}
+
+	// restore the additional orchestrator accounts of the validators
+	for _, orch := range data.AdditionalOrchestrators {
+		if err := orch.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("Invalid additional orchestrator in Genesis: %s", err))
+		}
+
+		val, _ := sdk.ValAddressFromBech32(orch.ValidatorAddress)
+		orchAddr, _ := sdk.AccAddressFromBech32(orch.OrchestratorAddress)
+		k.setAdditionalOrchestrator(ctx, val, orchAddr)
+	}
+
+	// populate state with governance-registered IBC denom metadata
+	for _, idm := range data.IbcDenomMetadata {
+		k.setIBCDenomMetadata(ctx, idm.Denom, idm.Metadata)
+	}
+
+	// restore the registered IBC forwards, under the accounts derived from them
+	for _, forward := range data.IbcForwards {
+		k.setIBCForward(ctx, *forward)
+	}
+
+	// restore the delegate key rotations, which are rate limited and trigger a signer set
+	for _, rotation := range data.DelegateKeysRotationHeights {
+		val, err := sdk.ValAddressFromBech32(rotation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setDelegateKeysRotationHeight(ctx, val, rotation.Height)
+	}
+	if data.LastEthereumKeyRotationHeight != 0 {
+		k.setLastEthereumKeyRotationHeight(ctx, data.LastEthereumKeyRotationHeight)
+	}
+
+	// restore the height of the last unbonding, which signer sets are still slashed for
+	if data.LastUnbondingBlockHeight != 0 {
+		k.setLastUnbondingBlockHeight(ctx, data.LastUnbondingBlockHeight)
+	}
+
+	// the top level state is the state of the default EVM chain
+	k.setDefaultEVMChain(ctx, data.Params.BridgeChainId)
+	initEVMChainGenesis(ctx, k.ForEVMChain(0), data)
+
+	for _, chain := range data.AdditionalEvmChains {
+		k.setEVMChain(ctx, chain.Params.BridgeChainId)
+		initEVMChainGenesis(ctx, k.ForEVMChain(chain.Params.BridgeChainId), *chain)
+	}
+}
+
+// initEVMChainGenesis sets the state of the EVM chain the keeper is scoped to.
+// The delegate keys must be set beforehand to resolve the confirmation signers.
+func initEVMChainGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 	k.SetParams(ctx, *data.Params)
 
 	// reset pool transactions in state
@@ -50,21 +118,52 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
-	// reset delegate keys in state
-	for _, keys := range data.DelegateKeys {
-		if err := keys.ValidateBasic(); err != nil {
-			panic(fmt.Sprintf("Invalid delegate key in Genesis: %s", err))
+	// restore the last event nonce of the validators, which can be above the nonce of their last vote still in state
+	for _, n := range data.LastEventNoncesByValidator {
+		val, err := sdk.ValAddressFromBech32(n.ValidatorAddress)
+		if err != nil {
+			panic(err)
 		}
+		k.setLastEventNonceByValidator(ctx, val, n.EventNonce)
+	}
 
-		val, _ := sdk.ValAddressFromBech32(keys.ValidatorAddress)
-		orch, _ := sdk.AccAddressFromBech32(keys.OrchestratorAddress)
-		eth := common.HexToAddress(keys.EthereumAddress)
+	// restore the ethereum heights last observed by the chain and by each validator
+	if data.LastObservedEthereumBlockHeight != nil {
+		height := data.LastObservedEthereumBlockHeight
+		k.SetLastObservedEthereumBlockHeightWithCosmos(ctx, height.EthereumHeight, height.CosmosHeight)
+	}
+	for _, vote := range data.EthereumHeightVotes {
+		val, err := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setEthereumHeightVote(ctx, val, vote.Height)
+	}
 
-		// set the orchestrator address
-		k.SetOrchestratorValidatorAddress(ctx, val, orch)
-		// set the ethereum address
-		k.setValidatorEthereumAddress(ctx, val, common.HexToAddress(keys.EthereumAddress))
-		k.setEthereumOrchestratorAddress(ctx, eth, orch)
+	// restore the ERC20 metadata votes of the tokens without voucher metadata
+	for _, vote := range data.Erc20MetadataVotes {
+		val, err := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setERC20MetadataVote(ctx, common.HexToAddress(vote.TokenContract), val, vote.Metadata)
+	}
+	if data.LastObservedSignerSet != nil {
+		k.setLastObservedSignerSetTx(ctx, *data.LastObservedSignerSet)
+	}
+
+	// restore the counters, so that new txs do not reuse the ids and nonces of the txs in state or already on ethereum
+	if data.LatestSignerSetTxNonce != 0 {
+		k.setLatestSignerSetTxNonce(ctx, data.LatestSignerSetTxNonce)
+	}
+	if data.LastOutgoingBatchNonce != 0 {
+		k.setLastOutgoingBatchNonce(ctx, data.LastOutgoingBatchNonce)
+	}
+	if data.LastSendToEthereumId != 0 {
+		k.setLastSendToEthereumID(ctx, data.LastSendToEthereumId)
+	}
+	if data.LastSlashedOutgoingTxBlockHeight != 0 {
+		k.SetLastSlashedOutgoingTxBlockHeight(ctx, data.LastSlashedOutgoingTxBlockHeight)
 	}
 
 	// populate state with cosmos originated denom-erc20 mapping
@@ -72,6 +171,21 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.setCosmosOriginatedDenomToERC20(ctx, item.Denom, common.HexToAddress(item.Erc20))
 	}
 
+	// populate state with pending erc20 deployment requests
+	for _, req := range data.Erc20DeploymentRequests {
+		k.setERC20DeploymentRequest(ctx, req)
+	}
+
+	// restore the bridge migration in progress, keeping the bridge frozen
+	if data.BridgeMigration != nil {
+		k.setBridgeMigration(ctx, *data.BridgeMigration)
+	}
+
+	// restore the signer set hijack incidents, keeping the bridge paused if any is unresolved
+	for _, incident := range data.SignerSetHijackIncidents {
+		k.setSignerSetHijackIncident(ctx, *incident)
+	}
+
 	// reset outgoing txs in state
 	for _, ota := range data.OutgoingTxs {
 		otx, err := types.UnpackOutgoingTx(ota)
@@ -81,34 +195,145 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetOutgoingTx(ctx, otx)
 	}
 
+	// reset completed outgoing txs in state, which are kept until they can no longer be slashed for
+	for _, ota := range data.CompletedOutgoingTxs {
+		otx, err := types.UnpackOutgoingTx(ota)
+		if err != nil {
+			panic(fmt.Sprintf("invalid completed outgoing tx any in genesis file: %s", err))
+		}
+		k.SetCompletedOutgoingTx(ctx, otx)
+	}
+
 	// reset signatures in state
 	for _, confa := range data.Confirmations {
 		conf, err := types.UnpackConfirmation(confa)
 		if err != nil {
 			panic(fmt.Sprintf("invalid etheruem signature in genesis: %s", err))
 		}
-		// TODO: not currently an easy way to get the validator address from the
-		// etherum address here. once we implement the third index for keys
-		// this will be easy.
-		k.SetEthereumSignature(ctx, conf, sdk.ValAddress{})
+		// the delegate keys are set first, so the signer resolves to its validator
+		orch := k.GetEthereumOrchestratorAddress(ctx, conf.GetSigner())
+		val := k.GetOrchestratorValidatorAddress(ctx, orch)
+		if val == nil {
+			panic(fmt.Sprintf("no validator for ethereum signer %s in genesis", conf.GetSigner().Hex()))
+		}
+		k.SetEthereumSignature(ctx, conf, val)
+	}
+	// the signatures made with a rotated ethereum key name their validator
+	for _, vc := range data.FormerKeyConfirmations {
+		conf, err := types.UnpackConfirmation(vc.Confirmation)
+		if err != nil {
+			panic(fmt.Sprintf("invalid former key confirmation in genesis: %s", err))
+		}
+		val, err := sdk.ValAddressFromBech32(vc.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetEthereumSignature(ctx, conf, val)
 	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
 // from the current state of the chain
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
+	var (
+		delegates               = k.getDelegateKeys(ctx)
+		ibcDenomMetadata        = k.getIBCDenomMetadatas(ctx)
+		additionalOrchestrators = k.getAdditionalOrchestrators(ctx)
+		ibcForwards             = k.getIBCForwards(ctx)
+		rotationHeights         []*types.DelegateKeysRotationHeight
+	)
+
+	k.iterateDelegateKeysRotationHeights(ctx, func(val sdk.ValAddress, height uint64) bool {
+		rotationHeights = append(rotationHeights, &types.DelegateKeysRotationHeight{
+			ValidatorAddress: val.String(),
+			Height:           height,
+		})
+		return false
+	})
+
+	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
+	for _, delegate := range delegates {
+		delegate.EthSignature = []byte("unused")
+	}
+
+	// the top level state is the state of the default EVM chain
+	genesis := exportEVMChainGenesis(ctx, k.ForEVMChain(0))
+	genesis.DelegateKeys = delegates
+	genesis.IbcDenomMetadata = ibcDenomMetadata
+	genesis.AdditionalOrchestrators = additionalOrchestrators
+	genesis.LastUnbondingBlockHeight = k.GetLastUnbondingBlockHeight(ctx)
+	genesis.DelegateKeysRotationHeights = rotationHeights
+	genesis.LastEthereumKeyRotationHeight = k.GetLastEthereumKeyRotationHeight(ctx)
+	genesis.IbcForwards = ibcForwards
+
+	for _, ck := range k.EVMChainKeepers(ctx)[1:] {
+		chain := exportEVMChainGenesis(ctx, ck)
+		genesis.AdditionalEvmChains = append(genesis.AdditionalEvmChains, &chain)
+	}
+
+	return genesis
+}
+
+// exportEVMChainGenesis exports the state of the EVM chain the keeper is
+// scoped to
+func exportEVMChainGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	var (
 		p                        = k.GetParams(ctx)
 		outgoingTxs              []*cdctypes.Any
 		ethereumTxConfirmations  []*cdctypes.Any
 		attmap                   = k.GetEthereumEventVoteRecordMapping(ctx)
 		ethereumEventVoteRecords []*types.EthereumEventVoteRecord
-		delegates                = k.getDelegateKeys(ctx)
 		lastobserved             = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms            []*types.ERC20ToDenom
 		unbatchedTransfers       = k.getUnbatchedSendToEthereums(ctx)
+		erc20DeploymentRequests  = k.getERC20DeploymentRequests(ctx)
+		bridgeMigration          = k.GetBridgeMigration(ctx)
+		hijackIncidents          = k.GetSignerSetHijackIncidents(ctx)
+		lastEventNonces          []*types.ValidatorEventNonce
+		lastObservedHeight       *types.LatestEthereumBlockHeight
+		heightVotes              []*types.EthereumHeightVote
+		completedOutgoingTxs     []*cdctypes.Any
+		formerKeyConfirmations   []*types.ValidatorConfirmation
 	)
 
+	// the signatures made with the current ethereum key of a validator resolve
+	// to it through the delegate keys, the others are exported with it
+	exportConfirmations := func(otx types.OutgoingTx) {
+		k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
+			conf, _ := types.PackConfirmation(makeConfirmation(otx, sig))
+			if common.HexToAddress(sig.EthereumSigner) == k.GetValidatorEthereumAddress(ctx, val) {
+				ethereumTxConfirmations = append(ethereumTxConfirmations, conf)
+			} else {
+				formerKeyConfirmations = append(formerKeyConfirmations, &types.ValidatorConfirmation{
+					ValidatorAddress: val.String(),
+					Confirmation:     conf,
+				})
+			}
+			return false
+		})
+	}
+
+	// export the last event nonce of each validator
+	k.iterateLastEventNonceByValidator(ctx, func(val sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, &types.ValidatorEventNonce{
+			ValidatorAddress: val.String(),
+			EventNonce:       nonce,
+		})
+		return false
+	})
+
+	// export the ethereum heights last observed by the chain and by each validator
+	if height := k.GetLastObservedEthereumBlockHeight(ctx); height != (types.LatestEthereumBlockHeight{}) {
+		lastObservedHeight = &height
+	}
+	k.IterateEthereumHeightVotes(ctx, func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) bool {
+		heightVotes = append(heightVotes, &types.EthereumHeightVote{
+			ValidatorAddress: val.String(),
+			Height:           height,
+		})
+		return false
+	})
+
 	// export ethereumEventVoteRecords from state
 	for _, atts := range attmap {
 		// TODO: set height = 0?
@@ -125,16 +350,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	k.IterateOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
-		sstx, _ := otx.(*types.SignerSetTx)
-		k.iterateEthereumSignatures(ctx, sstx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.SignerSetTxConfirmation{
-				SignerSetNonce: sstx.Nonce,
-				EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-				Signature:      sig,
-			})
-			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
-			return false
-		})
+		exportConfirmations(otx)
 		return false
 	})
 
@@ -142,17 +358,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
-		btx, _ := otx.(*types.BatchTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.BatchTxConfirmation{
-				TokenContract:  btx.TokenContract,
-				BatchNonce:     btx.BatchNonce,
-				EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-				Signature:      sig,
-			})
-			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
-			return false
-		})
+		exportConfirmations(otx)
 		return false
 	})
 
@@ -160,33 +366,66 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	k.IterateOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
 		ota, _ := types.PackOutgoingTx(otx)
 		outgoingTxs = append(outgoingTxs, ota)
-		btx, _ := otx.(*types.ContractCallTx)
-		k.iterateEthereumSignatures(ctx, btx.GetStoreIndex(), func(val sdk.ValAddress, sig []byte) bool {
-			siga, _ := types.PackConfirmation(&types.ContractCallTxConfirmation{
-				InvalidationScope: btx.InvalidationScope,
-				InvalidationNonce: btx.InvalidationNonce,
-				EthereumSigner:    k.GetValidatorEthereumAddress(ctx, val).Hex(),
-				Signature:         sig,
-			})
-			ethereumTxConfirmations = append(ethereumTxConfirmations, siga)
-			return false
-		})
+		exportConfirmations(otx)
 		return false
 	})
 
-	// this will marshal into "dW51c2Vk" as []byte will be encoded as base64
-	for _, delegate := range delegates {
-		delegate.EthSignature = []byte("unused")
-	}
+	// export completed txs and sigs, the sigs are kept to slash the validators that did not sign
+	k.IterateCompletedOutgoingTxs(ctx, func(_ []byte, otx types.OutgoingTx) bool {
+		ota, _ := types.PackOutgoingTx(otx)
+		completedOutgoingTxs = append(completedOutgoingTxs, ota)
+		exportConfirmations(otx)
+		return false
+	})
 
 	return types.GenesisState{
-		Params:                     &p,
-		LastObservedEventNonce:     lastobserved,
-		OutgoingTxs:                outgoingTxs,
-		Confirmations:              ethereumTxConfirmations,
-		EthereumEventVoteRecords:   ethereumEventVoteRecords,
-		DelegateKeys:               delegates,
-		Erc20ToDenoms:              erc20ToDenoms,
-		UnbatchedSendToEthereumTxs: unbatchedTransfers,
+		Params:                           &p,
+		LastObservedEventNonce:           lastobserved,
+		OutgoingTxs:                      outgoingTxs,
+		Confirmations:                    ethereumTxConfirmations,
+		EthereumEventVoteRecords:         ethereumEventVoteRecords,
+		Erc20ToDenoms:                    erc20ToDenoms,
+		UnbatchedSendToEthereumTxs:       unbatchedTransfers,
+		Erc20DeploymentRequests:          erc20DeploymentRequests,
+		BridgeMigration:                  bridgeMigration,
+		SignerSetHijackIncidents:         hijackIncidents,
+		LastEventNoncesByValidator:       lastEventNonces,
+		LatestSignerSetTxNonce:           k.GetLatestSignerSetTxNonce(ctx),
+		LastSlashedOutgoingTxBlockHeight: k.GetLastSlashedOutgoingTxBlockHeight(ctx),
+		LastOutgoingBatchNonce:           k.getLastOutgoingBatchNonce(ctx),
+		LastSendToEthereumId:             k.getLastSendToEthereumID(ctx),
+		LastObservedEthereumBlockHeight:  lastObservedHeight,
+		LastObservedSignerSet:            k.GetLastObservedSignerSetTx(ctx),
+		EthereumHeightVotes:              heightVotes,
+		Erc20MetadataVotes:               k.getERC20MetadataVotes(ctx),
+		CompletedOutgoingTxs:             completedOutgoingTxs,
+		FormerKeyConfirmations:           formerKeyConfirmations,
+	}
+}
+
+// makeConfirmation returns the confirmation of an outgoing tx by a stored signature
+func makeConfirmation(otx types.OutgoingTx, sig types.EthereumTxSignature) types.EthereumTxConfirmation {
+	switch otx := otx.(type) {
+	case *types.SignerSetTx:
+		return &types.SignerSetTxConfirmation{
+			SignerSetNonce: otx.Nonce,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
+		}
+	case *types.BatchTx:
+		return &types.BatchTxConfirmation{
+			TokenContract:  otx.TokenContract,
+			BatchNonce:     otx.BatchNonce,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
+		}
+	case *types.ContractCallTx:
+		return &types.ContractCallTxConfirmation{
+			InvalidationScope: otx.InvalidationScope,
+			InvalidationNonce: otx.InvalidationNonce,
+			EthereumSigner:    sig.EthereumSigner,
+			Signature:         sig.Signature,
+		}
 	}
+	panic(fmt.Sprintf("unknown outgoing tx type %T", otx))
 }
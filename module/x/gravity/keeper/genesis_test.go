@@ -5,7 +5,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
 // for the moment this is only testing delegate keys being set, but it would be good to make
@@ -23,6 +27,81 @@ func TestExportAndImport(t *testing.T) {
 	keeper.setEthereumOrchestratorAddress(ctx, ethAddr, orchAddr)
 	keeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
 
+	deploymentRequest := &types.ERC20DeploymentRequest{
+		Denom:         "ustake",
+		Erc20Name:     "stake",
+		Erc20Symbol:   "stake",
+		Erc20Decimals: 6,
+		Requester:     orchAddr.String(),
+		Height:        10,
+	}
+	keeper.setERC20DeploymentRequest(ctx, deploymentRequest)
+
+	ibcDenomMetadata := &types.IBCDenomMetadata{
+		Denom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Metadata: types.ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6},
+	}
+	keeper.setIBCDenomMetadata(ctx, ibcDenomMetadata.Denom, ibcDenomMetadata.Metadata)
+
+	signerSet := types.NewSignerSetTx(1, 1, types.EthereumSigners{{Power: 100, EthereumAddress: ethAddr.Hex()}})
+	keeper.SetOutgoingTx(ctx, signerSet)
+	keeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSet.Nonce,
+		EthereumSigner: ethAddr.Hex(),
+		Signature:      []byte("signature"),
+	}, valAddr)
+
+	erc20 := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	keeper.setCosmosOriginatedDenomToERC20(ctx, "ustake", erc20)
+
+	bridgeMigration := &types.BridgeMigration{
+		NewBridgeEthereumAddress: "0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7",
+		BridgeDeploymentHeight:   999,
+		Height:                   10,
+	}
+	keeper.setBridgeMigration(ctx, *bridgeMigration)
+
+	hijackIncident := &types.SignerSetHijackIncident{
+		Height:              12,
+		EventNonce:          4,
+		SignerSetTxNonce:    3,
+		ExpectedSignersHash: "AA",
+		ObservedSignersHash: "BB",
+		ObservedMembers:     []*types.EthereumSigner{{Power: 100, EthereumAddress: erc20.Hex()}},
+	}
+	keeper.setSignerSetHijackIncident(ctx, *hijackIncident)
+
+	standbyAddr, _ := sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+	keeper.setAdditionalOrchestrator(ctx, valAddr, standbyAddr)
+
+	forward := &types.IBCForward{Receiver: standbyAddr.String(), Channel: "channel-0"}
+	forwardAccount := keeper.setIBCForward(ctx, *forward)
+
+	completedBatch := &types.BatchTx{BatchNonce: 7, TokenContract: erc20.Hex(), Height: 5}
+	keeper.CompleteOutgoingTx(ctx, completedBatch)
+	keeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+		TokenContract:  completedBatch.TokenContract,
+		BatchNonce:     completedBatch.BatchNonce,
+		EthereumSigner: ethAddr.Hex(),
+		Signature:      []byte("batch signature"),
+	}, valAddr)
+
+	keeper.setLastEventNonceByValidator(ctx, valAddr, 9)
+	keeper.SetLastObservedEthereumBlockHeightWithCosmos(ctx, 1000, 20)
+	keeper.setEthereumHeightVote(ctx, valAddr, types.LatestEthereumBlockHeight{EthereumHeight: 1001, CosmosHeight: 21})
+	metadataVote := &types.ERC20MetadataVote{
+		ValidatorAddress: valAddr.String(),
+		TokenContract:    common.HexToAddress(TokenContractAddrs[1]).Hex(),
+		Metadata:         types.ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6},
+	}
+	keeper.setERC20MetadataVote(ctx, common.HexToAddress(metadataVote.TokenContract), valAddr, metadataVote.Metadata)
+	keeper.setLastObservedSignerSetTx(ctx, *signerSet)
+	keeper.setLatestSignerSetTxNonce(ctx, 3)
+	keeper.setLastOutgoingBatchNonce(ctx, 8)
+	keeper.setLastSendToEthereumID(ctx, 42)
+	keeper.SetLastSlashedOutgoingTxBlockHeight(ctx, 4)
+	keeper.setLastUnbondingBlockHeight(ctx, 6)
+
 	exportedGenesis := ExportGenesis(ctx, keeper)
 	newEnv := CreateTestEnv(t)
 	newCtx := newEnv.Context
@@ -33,4 +112,154 @@ func TestExportAndImport(t *testing.T) {
 	assert.Equal(t, newKeeper.GetValidatorEthereumAddress(newCtx, valAddr), ethAddr)
 	assert.Equal(t, newKeeper.GetEthereumOrchestratorAddress(newCtx, ethAddr), orchAddr)
 	assert.Equal(t, newKeeper.GetOrchestratorValidatorAddress(newCtx, orchAddr), valAddr)
+	assert.Equal(t, newKeeper.getERC20DeploymentRequests(newCtx), []*types.ERC20DeploymentRequest{deploymentRequest})
+	assert.Equal(t, newKeeper.getIBCDenomMetadatas(newCtx), []*types.IBCDenomMetadata{ibcDenomMetadata})
+
+	assert.Equal(t, bridgeMigration, newKeeper.GetBridgeMigration(newCtx))
+	assert.Equal(t, []*types.SignerSetHijackIncident{hijackIncident}, newKeeper.GetSignerSetHijackIncidents(newCtx))
+	assert.True(t, newKeeper.IsBridgePaused(newCtx))
+
+	assert.Equal(t, []sdk.AccAddress{standbyAddr}, newKeeper.GetAdditionalOrchestrators(newCtx, valAddr))
+	assert.Equal(t, valAddr, newKeeper.GetOrchestratorValidatorAddress(newCtx, standbyAddr))
+
+	newForward, ok := newKeeper.getIBCForward(newCtx, forwardAccount)
+	assert.True(t, ok)
+	assert.Equal(t, *forward, newForward)
+
+	assert.Equal(t, []byte("signature"), newKeeper.getEthereumSignature(newCtx, signerSet.GetStoreIndex(), valAddr))
+	assert.Equal(t, completedBatch, newKeeper.GetCompletedOutgoingTx(newCtx, completedBatch.GetStoreIndex()))
+	assert.Equal(t, []byte("batch signature"), newKeeper.getEthereumSignature(newCtx, completedBatch.GetStoreIndex(), valAddr))
+
+	assert.Equal(t, uint64(9), newKeeper.getLastEventNonceByValidator(newCtx, valAddr))
+	assert.Equal(t, types.LatestEthereumBlockHeight{EthereumHeight: 1000, CosmosHeight: 20}, newKeeper.GetLastObservedEthereumBlockHeight(newCtx))
+	assert.Equal(t, types.LatestEthereumBlockHeight{EthereumHeight: 1001, CosmosHeight: 21}, newKeeper.GetEthereumHeightVote(newCtx, valAddr))
+	assert.Equal(t, []*types.ERC20MetadataVote{metadataVote}, newKeeper.getERC20MetadataVotes(newCtx))
+	assert.Equal(t, signerSet, newKeeper.GetLastObservedSignerSetTx(newCtx))
+	assert.Equal(t, uint64(3), newKeeper.GetLatestSignerSetTxNonce(newCtx))
+	assert.Equal(t, uint64(9), newKeeper.incrementLastOutgoingBatchNonce(newCtx))
+	assert.Equal(t, uint64(43), newKeeper.incrementLastSendToEthereumIDKey(newCtx))
+	assert.Equal(t, uint64(4), newKeeper.GetLastSlashedOutgoingTxBlockHeight(newCtx))
+	assert.Equal(t, uint64(6), newKeeper.GetLastUnbondingBlockHeight(newCtx))
+
+	isCosmosOriginated, gotERC20, err := newKeeper.DenomToERC20Lookup(newCtx, "ustake")
+	assert.NoError(t, err)
+	assert.True(t, isCosmosOriginated)
+	assert.Equal(t, erc20, gotERC20)
+}
+
+func TestExportAndImportRotatedDelegateKeys(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	valAddr, _ := sdk.ValAddressFromBech32("cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z")
+	orchAddr, _ := sdk.AccAddressFromBech32("cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf")
+	newOrchAddr, _ := sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+	oldKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	newKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	oldEthAddr, newEthAddr := ethCrypto.PubkeyToAddress(oldKey.PublicKey), ethCrypto.PubkeyToAddress(newKey.PublicKey)
+
+	keeper.setValidatorEthereumAddress(ctx, valAddr, oldEthAddr)
+	keeper.setEthereumOrchestratorAddress(ctx, oldEthAddr, orchAddr)
+	keeper.SetOrchestratorValidatorAddress(ctx, valAddr, orchAddr)
+
+	gravityID := []byte(keeper.getGravityID(ctx))
+	signerSet := types.NewSignerSetTx(1, 1, types.EthereumSigners{{Power: 100, EthereumAddress: oldEthAddr.Hex()}})
+	keeper.SetOutgoingTx(ctx, signerSet)
+	oldSig, err := types.NewEthereumSignature(signerSet.GetCheckpoint(gravityID), oldKey)
+	require.NoError(t, err)
+	keeper.SetEthereumSignature(ctx, &types.SignerSetTxConfirmation{
+		SignerSetNonce: signerSet.Nonce,
+		EthereumSigner: oldEthAddr.Hex(),
+		Signature:      oldSig,
+	}, valAddr)
+
+	batch := &types.BatchTx{BatchNonce: 1, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Height: 1}
+	keeper.SetOutgoingTx(ctx, batch)
+
+	ctx = ctx.WithBlockHeight(100)
+	keeper.rotateDelegateKeys(ctx, valAddr, oldEthAddr, orchAddr, newEthAddr, newOrchAddr)
+	newSig, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), newKey)
+	require.NoError(t, err)
+	keeper.SetEthereumSignature(ctx, &types.BatchTxConfirmation{
+		TokenContract:  batch.TokenContract,
+		BatchNonce:     batch.BatchNonce,
+		EthereumSigner: newEthAddr.Hex(),
+		Signature:      newSig,
+	}, valAddr)
+
+	// the signature made with the rotated key is exported with its validator
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	require.NoError(t, exportedGenesis.ValidateBasic())
+	require.Len(t, exportedGenesis.Confirmations, 1)
+	require.Len(t, exportedGenesis.FormerKeyConfirmations, 1)
+	require.Equal(t, valAddr.String(), exportedGenesis.FormerKeyConfirmations[0].ValidatorAddress)
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	signers := make(map[string]string)
+	for _, otx := range []types.OutgoingTx{signerSet, batch} {
+		newKeeper.iterateEthereumSignatures(newCtx, otx.GetStoreIndex(), func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
+			require.Equal(t, valAddr, val)
+			signers[string(otx.GetStoreIndex())] = sig.EthereumSigner
+			return false
+		})
+	}
+	assert.Equal(t, oldEthAddr.Hex(), signers[string(signerSet.GetStoreIndex())])
+	assert.Equal(t, newEthAddr.Hex(), signers[string(batch.GetStoreIndex())])
+	assert.Equal(t, oldSig, newKeeper.getEthereumSignature(newCtx, signerSet.GetStoreIndex(), valAddr))
+
+	assert.Equal(t, uint64(100), newKeeper.GetDelegateKeysRotationHeight(newCtx, valAddr))
+	assert.Equal(t, uint64(100), newKeeper.GetLastEthereumKeyRotationHeight(newCtx))
+}
+
+func TestExportAndImportEVMChains(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	keeper := env.GravityKeeper
+
+	assert.NoError(t, NewMigrator(keeper).MigrateEVMChains(ctx))
+	defaultParams := keeper.GetParams(ctx)
+	params := defaultParams
+	params.BridgeChainId = 137
+	params.GravityId = "polygon"
+	assert.NoError(t, keeper.addEVMChain(ctx, params))
+
+	polygon := keeper.ForEVMChain(params.BridgeChainId)
+	erc20 := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	polygon.setCosmosOriginatedDenomToERC20(ctx, "ustake", erc20)
+	signerSet := types.NewSignerSetTx(1, 1, types.EthereumSigners{{Power: 100, EthereumAddress: erc20.Hex()}})
+	polygon.SetOutgoingTx(ctx, signerSet)
+
+	exportedGenesis := ExportGenesis(ctx, keeper)
+	assert.Len(t, exportedGenesis.AdditionalEvmChains, 1)
+	assert.NoError(t, exportedGenesis.ValidateBasic())
+
+	newEnv := CreateTestEnv(t)
+	newCtx := newEnv.Context
+	newKeeper := newEnv.GravityKeeper
+
+	InitGenesis(newCtx, newKeeper, exportedGenesis)
+
+	assert.Equal(t, defaultParams.BridgeChainId, newKeeper.GetDefaultEVMChainID(newCtx))
+	assert.Equal(t, []uint64{defaultParams.BridgeChainId, params.BridgeChainId}, newKeeper.GetEVMChainIDs(newCtx))
+	assert.Equal(t, defaultParams, newKeeper.GetParams(newCtx))
+
+	newPolygon := newKeeper.ForEVMChain(params.BridgeChainId)
+	assert.Equal(t, params, newPolygon.GetParams(newCtx))
+	assert.Equal(t, signerSet, newPolygon.GetOutgoingTx(newCtx, signerSet.GetStoreIndex()))
+	assert.Nil(t, newKeeper.GetOutgoingTx(newCtx, signerSet.GetStoreIndex()))
+
+	isCosmosOriginated, gotERC20, err := newPolygon.DenomToERC20Lookup(newCtx, "ustake")
+	assert.NoError(t, err)
+	assert.True(t, isCosmosOriginated)
+	assert.Equal(t, erc20, gotERC20)
+
+	_, _, err = newKeeper.DenomToERC20Lookup(newCtx, "ustake")
+	assert.Error(t, err)
 }
@@ -18,14 +18,23 @@ import (
 var _ types.QueryServer = Keeper{}
 
 func (k Keeper) Params(c context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	params := k.GetParams(sdk.UnwrapSDKContext(c))
 	return &types.ParamsResponse{Params: params}, nil
 }
 
 func (k Keeper) LatestSignerSetTx(c context.Context, req *types.LatestSignerSetTxRequest) (*types.SignerSetTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.OutgoingTxKey}, types.SignerSetTxPrefixByte))
+	store := prefix.NewStore(k.chainStore(ctx), append([]byte{types.OutgoingTxKey}, types.SignerSetTxPrefixByte))
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
 
@@ -49,6 +58,10 @@ func (k Keeper) LatestSignerSetTx(c context.Context, req *types.LatestSignerSetT
 
 func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*types.SignerSetTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
 	otx := k.GetOutgoingTx(ctx, key)
@@ -65,6 +78,11 @@ func (k Keeper) SignerSetTx(c context.Context, req *types.SignerSetTxRequest) (*
 }
 
 func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.BatchTxResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	if !common.IsHexAddress(req.TokenContract) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid hex address %s", req.TokenContract)
 	}
@@ -86,6 +104,11 @@ func (k Keeper) BatchTx(c context.Context, req *types.BatchTxRequest) (*types.Ba
 }
 
 func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxRequest) (*types.ContractCallTxResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
 	otx := k.GetOutgoingTx(sdk.UnwrapSDKContext(c), key)
 	if otx == nil {
@@ -101,6 +124,11 @@ func (k Keeper) ContractCallTx(c context.Context, req *types.ContractCallTxReque
 }
 
 func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest) (*types.SignerSetTxsResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	var signers []*types.SignerSetTx
 	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		signer, ok := otx.(*types.SignerSetTx)
@@ -119,6 +147,11 @@ func (k Keeper) SignerSetTxs(c context.Context, req *types.SignerSetTxsRequest)
 }
 
 func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.BatchTxsResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	var batches []*types.BatchTx
 	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		batch, ok := otx.(*types.BatchTx)
@@ -136,6 +169,11 @@ func (k Keeper) BatchTxs(c context.Context, req *types.BatchTxsRequest) (*types.
 }
 
 func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsRequest) (*types.ContractCallTxsResponse, error) {
+	k, err := k.evmChainKeeper(sdk.UnwrapSDKContext(c), req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+
 	var calls []*types.ContractCallTx
 	pageRes, err := k.PaginateOutgoingTxsByType(sdk.UnwrapSDKContext(c), req.Pagination, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) (hit bool) {
 		call, ok := otx.(*types.ContractCallTx)
@@ -154,14 +192,18 @@ func (k Keeper) ContractCallTxs(c context.Context, req *types.ContractCallTxsReq
 
 func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSetTxConfirmationsRequest) (*types.SignerSetTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeSignerSetTxKey(req.SignerSetNonce)
 
 	var out []*types.SignerSetTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		out = append(out, &types.SignerSetTxConfirmation{
 			SignerSetNonce: req.SignerSetNonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -171,15 +213,19 @@ func (k Keeper) SignerSetTxConfirmations(c context.Context, req *types.SignerSet
 
 func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfirmationsRequest) (*types.BatchTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeBatchTxKey(common.HexToAddress(req.TokenContract), req.BatchNonce)
 
 	var out []*types.BatchTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		out = append(out, &types.BatchTxConfirmation{
 			TokenContract:  req.TokenContract,
 			BatchNonce:     req.BatchNonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -188,15 +234,19 @@ func (k Keeper) BatchTxConfirmations(c context.Context, req *types.BatchTxConfir
 
 func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.ContractCallTxConfirmationsRequest) (*types.ContractCallTxConfirmationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	key := types.MakeContractCallTxKey(req.InvalidationScope, req.InvalidationNonce)
 
 	var out []*types.ContractCallTxConfirmation
-	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig []byte) bool {
+	k.iterateEthereumSignatures(ctx, key, func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		out = append(out, &types.ContractCallTxConfirmation{
 			InvalidationScope: req.InvalidationScope,
 			InvalidationNonce: req.InvalidationNonce,
-			EthereumSigner:    k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:         sig,
+			EthereumSigner:    sig.EthereumSigner,
+			Signature:         sig.Signature,
 		})
 		return false
 	})
@@ -206,6 +256,10 @@ func (k Keeper) ContractCallTxConfirmations(c context.Context, req *types.Contra
 // UnsignedSignerSetTxs returns all signer set txs that have not been signed by the given validator
 func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSignerSetTxsRequest) (*types.UnsignedSignerSetTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
@@ -217,6 +271,10 @@ func (k Keeper) UnsignedSignerSetTxs(c context.Context, req *types.UnsignedSigne
 // UnsignedBatchTxs returns all batch txs that have not been signed by the given validator
 func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsRequest) (*types.UnsignedBatchTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
@@ -228,6 +286,10 @@ func (k Keeper) UnsignedBatchTxs(c context.Context, req *types.UnsignedBatchTxsR
 // UnsignedContractCallTxs returns all contract call txs that have not been signed by the given validator
 func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedContractCallTxsRequest) (*types.UnsignedContractCallTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	val, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
@@ -238,6 +300,10 @@ func (k Keeper) UnsignedContractCallTxs(c context.Context, req *types.UnsignedCo
 
 func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSubmittedEthereumEventRequest) (*types.LastSubmittedEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	valAddr, err := k.getSignerValidator(ctx, req.Address)
 	if err != nil {
 		return nil, err
@@ -251,6 +317,10 @@ func (k Keeper) LastSubmittedEthereumEvent(c context.Context, req *types.LastSub
 
 func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*types.BatchTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	res := &types.BatchTxFeesResponse{}
 
 	// TODO: is this what we want here?
@@ -270,6 +340,10 @@ func (k Keeper) BatchTxFees(c context.Context, req *types.BatchTxFeesRequest) (*
 
 func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest) (*types.ERC20ToDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	cosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(req.Erc20))
 	res := &types.ERC20ToDenomResponse{
 		Denom:            denom,
@@ -280,51 +354,29 @@ func (k Keeper) ERC20ToDenom(c context.Context, req *types.ERC20ToDenomRequest)
 
 func (k Keeper) DenomToERC20Params(c context.Context, req *types.DenomToERC20ParamsRequest) (*types.DenomToERC20ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	if existingERC20, exists := k.getCosmosOriginatedERC20(ctx, req.Denom); exists {
-		return nil, errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"ERC20 token %s already exists for denom %s", existingERC20.Hex(), req.Denom,
-		)
-	}
-
-	// use metadata, if we can find it
-	if md, ok := k.bankKeeper.GetDenomMetaData(ctx, req.Denom); ok && md.Base != "" {
-		var erc20Decimals uint64
-		for _, denomUnit := range md.DenomUnits {
-			if denomUnit.Denom == md.Display {
-				erc20Decimals = uint64(denomUnit.Exponent)
-				break
-			}
-		}
-
-		return &types.DenomToERC20ParamsResponse{
-			BaseDenom:     md.Base,
-			Erc20Name:     md.Display,
-			Erc20Symbol:   md.Display,
-			Erc20Decimals: erc20Decimals,
-		}, nil
-	}
-
-	if supply := k.bankKeeper.GetSupply(ctx, req.Denom); supply.IsZero() {
-		return nil, errors.Wrapf(
-			types.ErrInvalidERC20Event,
-			"no supply exists for token %s without metadata", req.Denom,
-		)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
 	}
-
-	// no metadata, go with a zero decimal, no symbol erc-20
-	res := &types.DenomToERC20ParamsResponse{
-		BaseDenom:     req.Denom,
-		Erc20Name:     req.Denom,
-		Erc20Symbol:   "",
-		Erc20Decimals: 0,
+	params, err := k.expectedERC20DeploymentParams(ctx, req.Denom)
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	return &types.DenomToERC20ParamsResponse{
+		BaseDenom:     params.Denom,
+		Erc20Name:     params.Erc20Name,
+		Erc20Symbol:   params.Erc20Symbol,
+		Erc20Decimals: params.Erc20Decimals,
+	}, nil
 }
 
 func (k Keeper) DenomToERC20(c context.Context, req *types.DenomToERC20Request) (*types.DenomToERC20Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	cosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, req.Denom)
 	if err != nil {
 		return nil, err
@@ -338,6 +390,10 @@ func (k Keeper) DenomToERC20(c context.Context, req *types.DenomToERC20Request)
 
 func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSendToEthereumsRequest) (*types.BatchedSendToEthereumsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	res := &types.BatchedSendToEthereumsResponse{}
 
 	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, outgoing types.OutgoingTx) bool {
@@ -356,9 +412,13 @@ func (k Keeper) BatchedSendToEthereums(c context.Context, req *types.BatchedSend
 
 func (k Keeper) UnbatchedSendToEthereums(c context.Context, req *types.UnbatchedSendToEthereumsRequest) (*types.UnbatchedSendToEthereumsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	res := &types.UnbatchedSendToEthereumsResponse{}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey})
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.SendToEthereumKey})
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var ste types.SendToEthereum
 		k.cdc.MustUnmarshal(value, &ste)
@@ -388,6 +448,9 @@ func (k Keeper) DelegateKeysByValidator(c context.Context, req *types.DelegateKe
 		EthAddress:          ethAddr.Hex(),
 		OrchestratorAddress: orchAddr.String(),
 	}
+	for _, orch := range k.GetAdditionalOrchestrators(ctx, valAddr) {
+		res.AdditionalOrchestratorAddresses = append(res.AdditionalOrchestratorAddresses, orch.String())
+	}
 	return res, nil
 }
 
@@ -433,6 +496,10 @@ func (k Keeper) DelegateKeys(c context.Context, req *types.DelegateKeysRequest)
 
 func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObservedEthereumHeightRequest) (*types.LastObservedEthereumHeightResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	lastObservedEthereumHeight := k.GetLastObservedEthereumBlockHeight(ctx)
 
 	res := &types.LastObservedEthereumHeightResponse{
@@ -444,6 +511,10 @@ func (k Keeper) LastObservedEthereumHeight(c context.Context, req *types.LastObs
 
 func (k Keeper) CompletedBatchTxs(c context.Context, req *types.CompletedBatchTxsRequest) (*types.CompletedBatchTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 
 	var batches []*types.BatchTx
 	k.IterateCompletedOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -460,6 +531,10 @@ func (k Keeper) CompletedBatchTxs(c context.Context, req *types.CompletedBatchTx
 
 func (k Keeper) CompletedContractCallTxs(c context.Context, req *types.CompletedContractCallTxsRequest) (*types.CompletedContractCallTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 
 	var contractCalls []*types.ContractCallTx
 	k.IterateCompletedOutgoingTxsByType(ctx, types.ContractCallTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -476,6 +551,10 @@ func (k Keeper) CompletedContractCallTxs(c context.Context, req *types.Completed
 
 func (k Keeper) CompletedSignerSetTxs(c context.Context, req *types.CompletedSignerSetTxsRequest) (*types.CompletedSignerSetTxsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 
 	var signerSetCalls []*types.SignerSetTx
 	k.IterateCompletedOutgoingTxsByType(ctx, types.SignerSetTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
@@ -492,13 +571,17 @@ func (k Keeper) CompletedSignerSetTxs(c context.Context, req *types.CompletedSig
 
 func (k Keeper) BatchTxConfirmationsByValidator(c context.Context, req *types.BatchTxConfirmationsByValidatorRequest) (*types.BatchTxConfirmationsByValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	var confirms []*types.BatchTxConfirmation
-	k.IterateBatchTxEthereumSignatures(ctx, func(contractAddress common.Address, nonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateBatchTxEthereumSignatures(ctx, func(contractAddress common.Address, nonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		if !val.Equals(valAddr) {
 			return false
 		}
@@ -506,8 +589,8 @@ func (k Keeper) BatchTxConfirmationsByValidator(c context.Context, req *types.Ba
 		confirms = append(confirms, &types.BatchTxConfirmation{
 			TokenContract:  contractAddress.Hex(),
 			BatchNonce:     nonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -520,13 +603,17 @@ func (k Keeper) BatchTxConfirmationsByValidator(c context.Context, req *types.Ba
 
 func (k Keeper) ContractCallTxConfirmationsByValidator(c context.Context, req *types.ContractCallTxConfirmationsByValidatorRequest) (*types.ContractCallTxConfirmationsByValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	var confirms []*types.ContractCallTxConfirmation
-	k.IterateContractCallTxEthereumSignatures(ctx, func(invalidationScope []byte, invalidationNonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateContractCallTxEthereumSignatures(ctx, func(invalidationScope []byte, invalidationNonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		if !val.Equals(valAddr) {
 			return false
 		}
@@ -534,8 +621,8 @@ func (k Keeper) ContractCallTxConfirmationsByValidator(c context.Context, req *t
 		confirms = append(confirms, &types.ContractCallTxConfirmation{
 			InvalidationScope: invalidationScope,
 			InvalidationNonce: invalidationNonce,
-			EthereumSigner:    k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:         sig,
+			EthereumSigner:    sig.EthereumSigner,
+			Signature:         sig.Signature,
 		})
 		return false
 	})
@@ -548,21 +635,25 @@ func (k Keeper) ContractCallTxConfirmationsByValidator(c context.Context, req *t
 
 func (k Keeper) SignerSetTxConfirmationsByValidator(c context.Context, req *types.SignerSetTxConfirmationsByValidatorRequest) (*types.SignerSetTxConfirmationsByValidatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	var confirms []*types.SignerSetTxConfirmation
-	k.IterateSignerSetTxEthereumSignatures(ctx, func(nonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateSignerSetTxEthereumSignatures(ctx, func(nonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		if !val.Equals(valAddr) {
 			return false
 		}
 
 		confirms = append(confirms, &types.SignerSetTxConfirmation{
 			SignerSetNonce: nonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, val).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -575,6 +666,10 @@ func (k Keeper) SignerSetTxConfirmationsByValidator(c context.Context, req *type
 
 func (k Keeper) EthereumEventVoteRecords(c context.Context, req *types.EthereumEventVoteRecordsRequest) (*types.EthereumEventVoteRecordsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	res := &types.EthereumEventVoteRecordsResponse{}
 	pageRes, err := k.PaginateEthereumEventVoteRecords(ctx, req.Pagination, func(key []byte, eventVoteRecord *types.EthereumEventVoteRecord) bool {
 		res.Records = append(res.Records, eventVoteRecord)
@@ -592,6 +687,10 @@ func (k Keeper) EthereumEventVoteRecords(c context.Context, req *types.EthereumE
 
 func (k Keeper) EthereumEventVotes(c context.Context, req *types.EthereumEventVotesRequest) (*types.EthereumEventVotesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
 	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
 	if err != nil {
 		return nil, err
@@ -618,3 +717,51 @@ func (k Keeper) EthereumEventVotes(c context.Context, req *types.EthereumEventVo
 	}
 	return res, nil
 }
+
+func (k Keeper) ERC20DeploymentRequests(c context.Context, req *types.ERC20DeploymentRequestsRequest) (*types.ERC20DeploymentRequestsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+	return &types.ERC20DeploymentRequestsResponse{Requests: k.getERC20DeploymentRequests(ctx)}, nil
+}
+
+func (k Keeper) SignerSetDrift(c context.Context, req *types.SignerSetDriftRequest) (*types.SignerSetDriftResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+	// queries run against the last committed block, so report on the next one
+	return k.GetSignerSetDrift(ctx, uint64(ctx.BlockHeight())+1), nil
+}
+
+func (k Keeper) SignerSetHijackIncidents(c context.Context, req *types.SignerSetHijackIncidentsRequest) (*types.SignerSetHijackIncidentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignerSetHijackIncidentsResponse{
+		Incidents:    k.GetSignerSetHijackIncidents(ctx),
+		BridgePaused: k.IsBridgePaused(ctx),
+	}, nil
+}
+
+func (k Keeper) EVMChains(c context.Context, req *types.EVMChainsRequest) (*types.EVMChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.EVMChainsResponse{
+		EvmChainIds:       k.GetEVMChainIDs(ctx),
+		DefaultEvmChainId: k.GetDefaultEVMChainID(ctx),
+	}, nil
+}
+
+func (k Keeper) RelayableOutgoingTxs(c context.Context, req *types.RelayableOutgoingTxsRequest) (*types.RelayableOutgoingTxsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	k, err := k.evmChainKeeper(ctx, req.GetEvmChainId())
+	if err != nil {
+		return nil, err
+	}
+	return k.GetRelayableOutgoingTxs(ctx), nil
+}
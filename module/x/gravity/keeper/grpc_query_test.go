@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 	"github.com/stretchr/testify/require"
 )
@@ -361,3 +363,169 @@ func TestKeeper_UnsignedContractCallTxs(t *testing.T) {
 // DelegateKeysByValidator(context.Context, *DelegateKeysByValidatorRequest) (*DelegateKeysByValidatorResponse, error)
 // DelegateKeysByEthereumSigner(context.Context, *DelegateKeysByEthereumSignerRequest) (*DelegateKeysByEthereumSignerResponse, error)
 // DelegateKeysByOrchestrator(context.Context, *DelegateKeysByOrchestratorRequest) (*DelegateKeysByOrchestratorResponse, error)
+
+func TestKeeper_SignerSetDrift(t *testing.T) {
+	t.Run("read before there's anything in state", func(t *testing.T) {
+		env := CreateTestEnv(t)
+		gk := env.GravityKeeper
+
+		res, err := gk.SignerSetDrift(sdk.WrapSDKContext(env.Context), &types.SignerSetDriftRequest{})
+		require.NoError(t, err)
+		require.True(t, res.WillCreateSignerSet)
+		require.Equal(t, types.SignerSetReasonNoSignerSet, res.Reason)
+	})
+	t.Run("read after the validator set drifted", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+
+		sstx := gk.CreateSignerSetTx(ctx)
+		res, err := gk.SignerSetDrift(sdk.WrapSDKContext(ctx), &types.SignerSetDriftRequest{})
+		require.NoError(t, err)
+		require.False(t, res.WillCreateSignerSet)
+		require.Empty(t, res.Changes)
+		require.Equal(t, sstx.Nonce, res.LatestSignerSetNonce)
+		require.Equal(t, "0.050000000000000000", res.PowerDiffThreshold)
+
+		// move 4% of the power from one signer to another
+		delta := types.EthereumSigners(sstx.Signers).TotalPower() / 50
+		latestPowers := map[string]uint64{}
+		sstx.Signers[0].Power -= delta
+		sstx.Signers[1].Power += delta
+		for _, signer := range sstx.Signers[:2] {
+			latestPowers[signer.EthereumAddress] = signer.Power
+		}
+		gk.SetOutgoingTx(ctx, sstx)
+
+		res, err = gk.SignerSetDrift(sdk.WrapSDKContext(ctx), &types.SignerSetDriftRequest{})
+		require.NoError(t, err)
+		require.False(t, res.WillCreateSignerSet)
+		require.Len(t, res.Changes, 2)
+		for _, change := range res.Changes {
+			require.Equal(t, latestPowers[change.EthereumAddress], change.LatestPower)
+			require.NotEqual(t, change.LatestPower, change.CurrentPower)
+			val := gk.GetOrchestratorValidatorAddress(ctx, gk.GetEthereumOrchestratorAddress(ctx, common.HexToAddress(change.EthereumAddress)))
+			require.Equal(t, val.String(), change.ValidatorAddress)
+		}
+		powerDiff, err := sdk.NewDecFromStr(res.PowerDiff)
+		require.NoError(t, err)
+		require.True(t, powerDiff.GT(sdk.NewDecWithPrec(3, 2)))
+
+		// a lower threshold creates a new signer set in the next block
+		params := gk.GetParams(ctx)
+		params.SignerSetPowerDiffThreshold = sdk.NewDecWithPrec(3, 2)
+		gk.SetParams(ctx, params)
+
+		res, err = gk.SignerSetDrift(sdk.WrapSDKContext(ctx), &types.SignerSetDriftRequest{})
+		require.NoError(t, err)
+		require.True(t, res.WillCreateSignerSet)
+		require.Equal(t, types.SignerSetReasonPowerDiff, res.Reason)
+	})
+}
+
+func TestKeeper_RelayableOutgoingTxs(t *testing.T) {
+	t.Run("read before there's an observed signer set", func(t *testing.T) {
+		env := CreateTestEnv(t)
+		gk := env.GravityKeeper
+
+		res, err := gk.RelayableOutgoingTxs(sdk.WrapSDKContext(env.Context), &types.RelayableOutgoingTxsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.Txs)
+	})
+	t.Run("read the txs signed by enough of the observed signer set", func(t *testing.T) {
+		input, ctx := SetupFiveValChain(t)
+		gk := input.GravityKeeper
+
+		// give the validators ethereum keys the test can sign with
+		keys := make(map[common.Address]*ecdsa.PrivateKey)
+		vals := make(map[common.Address]sdk.ValAddress)
+		for _, val := range ValAddrs {
+			key, err := ethCrypto.GenerateKey()
+			require.NoError(t, err)
+			address := ethCrypto.PubkeyToAddress(key.PublicKey)
+			gk.setValidatorEthereumAddress(ctx, val, address)
+			keys[address], vals[address] = key, val
+		}
+
+		observed := gk.CreateSignerSetTx(ctx)
+		gk.setLastObservedSignerSetTx(ctx, *observed)
+		gk.SetLastObservedEthereumBlockHeight(ctx, 50)
+		// the members are sorted by power, the last one holds almost none
+		members := observed.Signers
+		gravityID := []byte(gk.getGravityID(ctx))
+		// confirm stores the signature of the member key as the confirmation of
+		// the member signer
+		confirm := func(otx types.OutgoingTx, signer, key int) {
+			keyAddress := common.HexToAddress(members[key].EthereumAddress)
+			sig, err := types.NewEthereumSignature(otx.GetCheckpoint(gravityID), keys[keyAddress])
+			require.NoError(t, err)
+
+			ethSigner := members[signer].EthereumAddress
+			var confirmation types.EthereumTxConfirmation
+			switch otx := otx.(type) {
+			case *types.SignerSetTx:
+				confirmation = &types.SignerSetTxConfirmation{SignerSetNonce: otx.Nonce, EthereumSigner: ethSigner, Signature: sig}
+			case *types.BatchTx:
+				confirmation = &types.BatchTxConfirmation{TokenContract: otx.TokenContract, BatchNonce: otx.BatchNonce, EthereumSigner: ethSigner, Signature: sig}
+			case *types.ContractCallTx:
+				confirmation = &types.ContractCallTxConfirmation{InvalidationScope: otx.InvalidationScope, InvalidationNonce: otx.InvalidationNonce, EthereumSigner: ethSigner, Signature: sig}
+			}
+			gk.SetEthereumSignature(ctx, confirmation, vals[common.HexToAddress(members[signer].EthereumAddress)])
+		}
+		sign := func(otx types.OutgoingTx, signers ...int) {
+			for _, i := range signers {
+				confirm(otx, i, i)
+			}
+		}
+
+		next := gk.CreateSignerSetTx(ctx)
+		sign(next, 0, 1, 2, 3, 4)
+
+		tokenContract := common.HexToAddress("0x835973768750b3ED2D5c3EF5AdcD5eDb44d12aD4")
+		batch := &types.BatchTx{BatchNonce: 1, Timeout: 100, TokenContract: tokenContract.Hex()}
+		gk.SetOutgoingTx(ctx, batch)
+		sign(batch, 3, 1, 0)
+		// the signatures of a timed out batch don't matter
+		timedOut := &types.BatchTx{BatchNonce: 2, Timeout: 50, TokenContract: tokenContract.Hex()}
+		gk.SetOutgoingTx(ctx, timedOut)
+		sign(timedOut, 0, 1, 2, 3, 4)
+		// a signature by another key doesn't count
+		call := &types.ContractCallTx{InvalidationNonce: 1, InvalidationScope: bytes.HexBytes("scope"), Timeout: 100}
+		gk.SetOutgoingTx(ctx, call)
+		sign(call, 0, 1, 4)
+		confirm(call, 2, 3)
+
+		// a signature made before its validator rotated the ethereum key still
+		// counts for the member of the observed signer set
+		sign(batch, 2)
+		rotatedKey, err := ethCrypto.GenerateKey()
+		require.NoError(t, err)
+		gk.setValidatorEthereumAddress(ctx, vals[common.HexToAddress(members[2].EthereumAddress)], ethCrypto.PubkeyToAddress(rotatedKey.PublicKey))
+
+		res, err := gk.RelayableOutgoingTxs(sdk.WrapSDKContext(ctx), &types.RelayableOutgoingTxsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, observed.Nonce, res.SignerSetNonce)
+		require.Len(t, res.Txs, 2)
+
+		signerSetTx, err := types.UnpackOutgoingTx(res.Txs[0].OutgoingTx)
+		require.NoError(t, err)
+		require.Equal(t, next.Nonce, signerSetTx.(*types.SignerSetTx).Nonce)
+		require.Equal(t, types.EthereumSigners(members).TotalPower(), res.Txs[0].SignedPower)
+
+		batchTx, err := types.UnpackOutgoingTx(res.Txs[1].OutgoingTx)
+		require.NoError(t, err)
+		require.Equal(t, batch.BatchNonce, batchTx.(*types.BatchTx).BatchNonce)
+		relayable := res.Txs[1]
+		require.Equal(t, batch.GetCheckpoint(gravityID), relayable.Checkpoint)
+		require.Greater(t, relayable.SignedPower, types.ContractPowerThreshold)
+		require.Len(t, relayable.Signatures, len(members))
+		for i, sig := range relayable.Signatures {
+			require.Equal(t, members[i].EthereumAddress, sig.EthereumSigner)
+			require.Equal(t, members[i].Power, sig.Power)
+			if i == 4 {
+				require.Empty(t, sig.Signature)
+				continue
+			}
+			require.NoError(t, types.ValidateEthereumSignature(relayable.Checkpoint, sig.Signature, common.HexToAddress(sig.EthereumSigner)))
+		}
+	})
+}
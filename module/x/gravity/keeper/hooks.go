@@ -18,7 +18,7 @@ func (k Keeper) Hooks() Hooks { return Hooks{k} }
 func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddress sdk.ValAddress) error {
 
 	// When Validator starts Unbonding, Persist the block height in the store if their power is greater
-	// than 1% of the total power.
+	// than the unbonding power fraction of the total power.
 	// Later in endblocker, check if this persisted block height is the current one and create a signer set tx if it is.
 	// The reason for creating signer set txs in endblock is to create only one valset request per block,
 	// if multiple validators starts unbonding at same block.
@@ -28,29 +28,43 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress,
 		return nil
 	}
 
-	latestSignerSet := h.k.GetLatestSignerSetTx(ctx)
-	ethAddress := h.k.GetValidatorEthereumAddress(ctx, valAddress).Hex()
+	// the unbonding height is shared by all EVM chains, each of them creating a
+	// signer set tx at that height
+	for _, ck := range h.k.EVMChainKeepers(ctx) {
+		if unbondingPowerExceeded(ctx, ck, valAddress) {
+			h.k.setLastUnbondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// unbondingPowerExceeded returns true if the validator's power in the latest
+// signer set of the EVM chain is greater than the unbonding power fraction
+func unbondingPowerExceeded(ctx sdk.Context, k Keeper, valAddress sdk.ValAddress) bool {
+	latestSignerSet := k.GetLatestSignerSetTx(ctx)
+	if latestSignerSet == nil {
+		return false
+	}
+
+	ethAddress := k.GetValidatorEthereumAddress(ctx, valAddress).Hex()
 	power := uint64(0)
 	totalPower := uint64(0)
 	for _, s := range latestSignerSet.Signers {
 		if s.EthereumAddress == ethAddress {
 			power = s.Power
-			break
 		}
 
 		totalPower += s.Power
 	}
 
 	if totalPower == 0 {
-		return nil
+		return false
 	}
 
-	proportion := float64(power) / float64(totalPower)
-	if proportion > 0.01 {
-		h.k.setLastUnbondingBlockHeight(ctx, uint64(ctx.BlockHeight()))
-	}
-
-	return nil
+	proportion := sdk.NewDecFromInt(sdk.NewIntFromUint64(power)).QuoInt(sdk.NewIntFromUint64(totalPower))
+	return proportion.GT(k.GetParams(ctx).SignerSetUnbondingPowerFraction)
 }
 
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
@@ -112,6 +126,58 @@ func (k Keeper) AfterSendToCosmosEvent(ctx sdk.Context, event types.SendToCosmos
 	}
 }
 
+func (k Keeper) BeforeSendToCosmos(ctx sdk.Context, event *types.SendToCosmosEvent) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeSendToCosmos(ctx, event)
+	}
+	return nil
+}
+
+func (k Keeper) BeforeSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeSendToEthereum(ctx, sender, ethereumRecipient, amount, fee)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSendToEthereumCreated(ctx sdk.Context, send types.SendToEthereum) error {
+	if k.hooks != nil {
+		return k.hooks.AfterSendToEthereumCreated(ctx, send)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSendToEthereumCancelled(ctx sdk.Context, send types.SendToEthereum) error {
+	if k.hooks != nil {
+		return k.hooks.AfterSendToEthereumCancelled(ctx, send)
+	}
+	return nil
+}
+
+func (k Keeper) AfterBatchCreated(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchCreated(ctx, batch)
+	}
+}
+
+func (k Keeper) AfterBatchTimedOut(ctx sdk.Context, batch types.BatchTx) {
+	if k.hooks != nil {
+		k.hooks.AfterBatchTimedOut(ctx, batch)
+	}
+}
+
+func (k Keeper) AfterSignerSetTxCreated(ctx sdk.Context, signerSet types.SignerSetTx) {
+	if k.hooks != nil {
+		k.hooks.AfterSignerSetTxCreated(ctx, signerSet)
+	}
+}
+
+func (k Keeper) AfterOutgoingTxCompleted(ctx sdk.Context, otx types.OutgoingTx) {
+	if k.hooks != nil {
+		k.hooks.AfterOutgoingTxCompleted(ctx, otx)
+	}
+}
+
 func (k *Keeper) SetHooks(sh types.GravityHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set gravity hooks twice")
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// eventHooks only implements the Ethereum event notifications
+type eventHooks struct {
+	deposits []types.SendToCosmosEvent
+}
+
+func (h *eventHooks) AfterContractCallExecutedEvent(sdk.Context, types.ContractCallExecutedEvent) {}
+func (h *eventHooks) AfterERC20DeployedEvent(sdk.Context, types.ERC20DeployedEvent)               {}
+func (h *eventHooks) AfterSignerSetExecutedEvent(sdk.Context, types.SignerSetTxExecutedEvent)     {}
+func (h *eventHooks) AfterBatchExecutedEvent(sdk.Context, types.BatchExecutedEvent)               {}
+func (h *eventHooks) AfterSendToCosmosEvent(_ sdk.Context, event types.SendToCosmosEvent) {
+	h.deposits = append(h.deposits, event)
+}
+
+// flowHooks implements the bridge flow hooks as well
+type flowHooks struct {
+	eventHooks
+	rejectDeposit     bool
+	redirectTo        string
+	inflateDeposit    bool
+	rejectSend        bool
+	created           []types.SendToEthereum
+	cancelled         []types.SendToEthereum
+	rejectAfterCancel bool
+}
+
+func (h *flowHooks) BeforeSendToCosmos(_ sdk.Context, event *types.SendToCosmosEvent) error {
+	if h.rejectDeposit {
+		return errors.New("deposit rejected")
+	}
+	if h.redirectTo != "" {
+		event.CosmosReceiver = h.redirectTo
+	}
+	if h.inflateDeposit {
+		event.Amount = event.Amount.MulRaw(2)
+	}
+	return nil
+}
+
+func (h *flowHooks) BeforeSendToEthereum(sdk.Context, sdk.AccAddress, string, sdk.Coin, sdk.Coin) error {
+	if h.rejectSend {
+		return errors.New("send rejected")
+	}
+	return nil
+}
+
+func (h *flowHooks) AfterSendToEthereumCreated(_ sdk.Context, send types.SendToEthereum) error {
+	h.created = append(h.created, send)
+	return nil
+}
+
+func (h *flowHooks) AfterSendToEthereumCancelled(_ sdk.Context, send types.SendToEthereum) error {
+	h.cancelled = append(h.cancelled, send)
+	if h.rejectAfterCancel {
+		return errors.New("cancel rejected")
+	}
+	return nil
+}
+
+func TestBridgeFlowHooks(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	events, flow := &eventHooks{}, &flowHooks{}
+	input.GravityKeeper.SetHooks(types.NewMultiGravityHooks(events, flow))
+
+	tokenContract := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.GravityDenom(tokenContract)
+	deposit := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  tokenContract.Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: AccAddrs[0].String(),
+	}
+
+	// a rejected deposit is rolled back entirely
+	flow.rejectDeposit = true
+	input.GravityKeeper.processEthereumEvent(ctx, deposit)
+	require.True(t, input.BankKeeper.GetSupply(ctx, denom).IsZero())
+	require.Empty(t, events.deposits)
+
+	// a redirected deposit is credited to the new receiver
+	flow.rejectDeposit = false
+	flow.redirectTo = AccAddrs[1].String()
+	input.GravityKeeper.processEthereumEvent(ctx, deposit)
+	require.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[0], denom).IsZero())
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount)
+	require.Len(t, events.deposits, 1)
+	require.Equal(t, AccAddrs[1].String(), events.deposits[0].CosmosReceiver)
+	require.Equal(t, AccAddrs[0].String(), deposit.CosmosReceiver)
+
+	// hooks cannot change what the deposit mints
+	flow.inflateDeposit = true
+	input.GravityKeeper.processEthereumEvent(ctx, deposit)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.Len(t, events.deposits, 1)
+	flow.inflateDeposit = false
+
+	// sends can be rejected before any coins move
+	amount, fee := sdk.NewInt64Coin(denom, 50), sdk.NewInt64Coin(denom, 10)
+	flow.rejectSend = true
+	_, err := input.GravityKeeper.createSendToEthereum(ctx, AccAddrs[1], EthAddrs[1].Hex(), amount, fee)
+	require.Error(t, err)
+	require.Empty(t, flow.created)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount)
+
+	flow.rejectSend = false
+	id, err := input.GravityKeeper.createSendToEthereum(ctx, AccAddrs[1], EthAddrs[1].Hex(), amount, fee)
+	require.NoError(t, err)
+	require.Len(t, flow.created, 1)
+	require.Equal(t, id, flow.created[0].Id)
+
+	// a rejected cancellation returns the hook error
+	flow.rejectAfterCancel = true
+	require.Error(t, input.GravityKeeper.cancelSendToEthereum(ctx, id, AccAddrs[1].String()))
+
+	flow.rejectAfterCancel = false
+	id, err = input.GravityKeeper.createSendToEthereum(ctx, AccAddrs[1], EthAddrs[1].Hex(), amount, fee)
+	require.NoError(t, err)
+	require.NoError(t, input.GravityKeeper.cancelSendToEthereum(ctx, id, AccAddrs[1].String()))
+	require.Equal(t, id, flow.cancelled[len(flow.cancelled)-1].Id)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// getIBCForward returns the IBC forward registered for the account, if any
+func (k Keeper) getIBCForward(ctx sdk.Context, account sdk.AccAddress) (types.IBCForward, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MakeIBCForwardKey(account))
+	if bz == nil {
+		return types.IBCForward{}, false
+	}
+
+	var forward types.IBCForward
+	k.cdc.MustUnmarshal(bz, &forward)
+	return forward, true
+}
+
+// setIBCForward registers the IBC forward under its account and returns the
+// account
+func (k Keeper) setIBCForward(ctx sdk.Context, forward types.IBCForward) sdk.AccAddress {
+	account := types.IBCForwardAccount(forward)
+	ctx.KVStore(k.storeKey).Set(types.MakeIBCForwardKey(account), k.cdc.MustMarshal(&forward))
+	return account
+}
+
+// iterateIBCForwards iterates over the registered IBC forwards
+func (k Keeper) iterateIBCForwards(ctx sdk.Context, cb func(*types.IBCForward) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.IBCForwardKey})
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var forward types.IBCForward
+		k.cdc.MustUnmarshal(iter.Value(), &forward)
+		// cb returns true to stop early
+		if cb(&forward) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getIBCForwards(ctx sdk.Context) (out []*types.IBCForward) {
+	k.iterateIBCForwards(ctx, func(forward *types.IBCForward) bool {
+		out = append(out, forward)
+		return false
+	})
+	return
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// RegisterInvariants registers all gravity invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-escrow", ModuleEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "ethereum-vouchers", EthereumVouchersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "denom-erc20-index", DenomToERC20IndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "batched-sends", BatchedSendsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the gravity module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ModuleEscrowInvariant(k),
+			EthereumVouchersInvariant(k),
+			DenomToERC20IndexInvariant(k),
+			BatchedSendsInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ModuleEscrowInvariant checks that the gravity module account holds enough
+// Cosmos-originated tokens to cover the amounts and fees of all unbatched and
+// batched sends to Ethereum. It may hold more, since the tokens of executed
+// batches stay locked in the module account while they are on Ethereum.
+func ModuleEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// a Cosmos-originated denom may be bridged to several EVM chains, all
+		// of them escrowing it in the module account
+		var needed sdk.Coins
+		for _, ck := range k.EVMChainKeepers(ctx) {
+			for _, token := range ck.pendingSendToEthereumTokens(ctx) {
+				isCosmosOriginated, denom := ck.ERC20ToDenomLookup(ctx, common.HexToAddress(token.Contract))
+				if isCosmosOriginated && token.Amount.IsPositive() {
+					needed = needed.Add(sdk.NewCoin(denom, token.Amount))
+				}
+			}
+		}
+
+		balances := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		for _, coin := range needed {
+			if balance := balances.AmountOf(coin.Denom); balance.LT(coin.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\tmodule account holds %s%s but pending sends to Ethereum need %s\n", balance, coin.Denom, coin)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module escrow",
+			fmt.Sprintf("insufficient Cosmos-originated tokens escrowed for pending sends to Ethereum\n%s", msg),
+		), broken
+	}
+}
+
+// EthereumVouchersInvariant checks that the gravity module account holds no
+// vouchers for Ethereum-originated tokens. Vouchers are burned as soon as a
+// send to Ethereum is created and minted straight to their receiver when
+// deposits are observed, so none are held for pending outgoing txs.
+func EthereumVouchersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		balances := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		for _, coin := range balances {
+			if isGravityDenom(coin.Denom) {
+				broken = true
+				msg += fmt.Sprintf("\tmodule account holds %s\n", coin)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "ethereum vouchers",
+			fmt.Sprintf("Ethereum-originated vouchers held by the module account\n%s", msg),
+		), broken
+	}
+}
+
+// DenomToERC20IndexInvariant checks that every Cosmos-originated denom to
+// ERC20 entry has a matching ERC20 to denom entry and vice versa
+func DenomToERC20IndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, ck := range k.EVMChainKeepers(ctx) {
+			store := ck.chainStore(ctx)
+			denomToERC20 := prefix.NewStore(store, []byte{types.DenomToERC20Key})
+			iter := denomToERC20.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				denom, contract := string(iter.Key()), common.BytesToAddress(iter.Value())
+				if indexed, found := ck.getCosmosOriginatedDenom(ctx, contract); !found || indexed != denom {
+					broken = true
+					msg += fmt.Sprintf("\tevm chain %d: denom %s maps to ERC20 %s, which maps to %q\n", ck.EVMChainID(ctx), denom, contract.Hex(), indexed)
+				}
+			}
+			iter.Close()
+
+			erc20ToDenom := prefix.NewStore(store, []byte{types.ERC20ToDenomKey})
+			iter = erc20ToDenom.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				contract, denom := common.BytesToAddress(iter.Key()), string(iter.Value())
+				if indexed, found := ck.getCosmosOriginatedERC20(ctx, denom); !found || indexed != contract {
+					broken = true
+					msg += fmt.Sprintf("\tevm chain %d: ERC20 %s maps to denom %s, which is not mapped back to it\n", ck.EVMChainID(ctx), contract.Hex(), denom)
+				}
+			}
+			iter.Close()
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "denom erc20 index",
+			fmt.Sprintf("mismatched Cosmos-originated denom and ERC20 indexes\n%s", msg),
+		), broken
+	}
+}
+
+// BatchedSendsInvariant checks that no send to Ethereum is both in a batch
+// and in the unbatched pool, or in more than one batch
+func BatchedSendsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// send ids are assigned per EVM chain
+		for _, ck := range k.EVMChainKeepers(ctx) {
+			evmChainID := ck.EVMChainID(ctx)
+			unbatched := make(map[uint64]bool)
+			ck.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+				unbatched[ste.Id] = true
+				return false
+			})
+
+			batched := make(map[uint64]uint64)
+			ck.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+				btx, _ := otx.(*types.BatchTx)
+				for _, ste := range btx.Transactions {
+					if unbatched[ste.Id] {
+						broken = true
+						msg += fmt.Sprintf("\tevm chain %d: send %d is in batch %d and in the unbatched pool\n", evmChainID, ste.Id, btx.BatchNonce)
+					}
+					if nonce, ok := batched[ste.Id]; ok {
+						broken = true
+						msg += fmt.Sprintf("\tevm chain %d: send %d is in batches %d and %d\n", evmChainID, ste.Id, nonce, btx.BatchNonce)
+					}
+					batched[ste.Id] = btx.BatchNonce
+				}
+				return false
+			})
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "batched sends",
+			fmt.Sprintf("sends to Ethereum batched more than once\n%s", msg),
+		), broken
+	}
+}
+
+// pendingSendToEthereumTokens sums the amounts and fees of all unbatched and
+// batched sends to Ethereum by token contract, in order of first appearance
+func (k Keeper) pendingSendToEthereumTokens(ctx sdk.Context) []types.ERC20Token {
+	var (
+		totals []types.ERC20Token
+		index  = make(map[common.Address]int)
+	)
+	add := func(ste *types.SendToEthereum) {
+		for _, token := range []types.ERC20Token{ste.Erc20Token, ste.Erc20Fee} {
+			contract := common.HexToAddress(token.Contract)
+			i, ok := index[contract]
+			if !ok {
+				i = len(totals)
+				index[contract] = i
+				totals = append(totals, types.NewSDKIntERC20Token(sdk.ZeroInt(), contract))
+			}
+			totals[i].Amount = totals[i].Amount.Add(token.Amount)
+		}
+	}
+
+	k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+		add(ste)
+		return false
+	})
+	k.IterateOutgoingTxsByType(ctx, types.BatchTxPrefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+		btx, _ := otx.(*types.BatchTx)
+		for _, ste := range btx.Transactions {
+			add(ste)
+		}
+		return false
+	})
+
+	return totals
+}
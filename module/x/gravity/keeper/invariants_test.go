@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestModuleEscrowInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	contract := common.HexToAddress(TokenContractAddrs[0])
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ustake", contract)
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("ustake", 1000))))
+
+	for i := 0; i < 4; i++ {
+		_, err := gk.createSendToEthereum(ctx, AccAddrs[0], EthAddrs[0].Hex(), sdk.NewInt64Coin("ustake", 100), sdk.NewInt64Coin("ustake", 10))
+		require.NoError(t, err)
+	}
+	gk.CreateBatchTx(ctx, contract, 2)
+
+	_, broken := ModuleEscrowInvariant(gk)(ctx)
+	require.False(t, broken)
+
+	// releasing escrowed tokens leaves the pending sends uncovered
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("ustake", 1))))
+	msg, broken := ModuleEscrowInvariant(gk)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "439ustake")
+}
+
+func TestEthereumVouchersInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	vouchers := sdk.NewCoins(types.NewERC20Token(1000, common.HexToAddress(TokenContractAddrs[0])).GravityCoin())
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[0], vouchers))
+
+	// vouchers are burned when sends to Ethereum are created
+	input.AddSendToEthTxsToPool(t, ctx, common.HexToAddress(TokenContractAddrs[0]), AccAddrs[0], EthAddrs[0], 1, 2)
+	_, broken := EthereumVouchersInvariant(gk)(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(vouchers[0].Denom, sdk.NewInt(1)))))
+	_, broken = EthereumVouchersInvariant(gk)(ctx)
+	require.True(t, broken)
+}
+
+func TestDenomToERC20IndexInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	contract := common.HexToAddress(TokenContractAddrs[0])
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ustake", contract)
+	_, broken := DenomToERC20IndexInvariant(gk)(ctx)
+	require.False(t, broken)
+
+	ctx.KVStore(gk.storeKey).Delete(types.MakeERC20ToDenomKey(contract))
+	_, broken = DenomToERC20IndexInvariant(gk)(ctx)
+	require.True(t, broken)
+
+	// a dangling reverse entry is caught as well
+	ctx.KVStore(gk.storeKey).Delete(types.MakeDenomToERC20Key("ustake"))
+	ctx.KVStore(gk.storeKey).Set(types.MakeERC20ToDenomKey(contract), []byte("ustake"))
+	_, broken = DenomToERC20IndexInvariant(gk)(ctx)
+	require.True(t, broken)
+}
+
+func TestBatchedSendsInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	contract := common.HexToAddress(TokenContractAddrs[0])
+	require.NoError(t, fundAccount(ctx, input.BankKeeper, AccAddrs[0], sdk.NewCoins(types.NewERC20Token(1000, contract).GravityCoin())))
+	input.AddSendToEthTxsToPool(t, ctx, contract, AccAddrs[0], EthAddrs[0], 1, 2, 3)
+	batch := gk.CreateBatchTx(ctx, contract, 2)
+
+	_, broken := BatchedSendsInvariant(gk)(ctx)
+	require.False(t, broken)
+	_, broken = AllInvariants(gk)(ctx)
+	require.False(t, broken)
+
+	// a batched send put back into the pool while its batch is still pending
+	gk.setUnbatchedSendToEthereum(ctx, batch.Transactions[0])
+	msg, broken := BatchedSendsInvariant(gk)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "in the unbatched pool")
+}
@@ -28,7 +28,7 @@ import (
 type Keeper struct {
 	StakingKeeper          types.StakingKeeper
 	storeKey               storetypes.StoreKey
-	paramSpace             paramtypes.Subspace
+	legacySubspace         paramtypes.Subspace
 	cdc                    codec.Codec
 	accountKeeper          types.AccountKeeper
 	bankKeeper             types.BankKeeper
@@ -36,15 +36,24 @@ type Keeper struct {
 	DistributionKeeper     types.DistributionKeeper
 	PowerReduction         sdkmath.Int
 	hooks                  types.GravityHooks
+	transferKeeper         types.TransferKeeper
 	ReceiverModuleAccounts map[string]string
 	SenderModuleAccounts   map[string]string
+
+	// the EVM chain whose state the keeper reads and writes, 0 for the
+	// default chain, see ForEVMChain
+	evmChainID uint64
+
+	// the address capable of executing governance messages, typically the
+	// x/gov module account
+	authority string
 }
 
 // NewKeeper returns a new instance of the gravity keeper
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
-	paramSpace paramtypes.Subspace,
+	legacySubspace paramtypes.Subspace,
 	accKeeper types.AccountKeeper,
 	stakingKeeper types.StakingKeeper,
 	bankKeeper types.BankKeeper,
@@ -53,15 +62,17 @@ func NewKeeper(
 	powerReduction sdkmath.Int,
 	receiverModuleAccounts map[string]string,
 	senderModuleAccounts map[string]string,
+	authority string,
 ) Keeper {
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	// set KeyTable if it has not already been set, the legacy subspace is
+	// only read when migrating the params into the gravity store
+	if !legacySubspace.HasKeyTable() {
+		legacySubspace = legacySubspace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	k := Keeper{
 		cdc:                    cdc,
-		paramSpace:             paramSpace,
+		legacySubspace:         legacySubspace,
 		storeKey:               storeKey,
 		accountKeeper:          accKeeper,
 		StakingKeeper:          stakingKeeper,
@@ -71,11 +82,30 @@ func NewKeeper(
 		PowerReduction:         powerReduction,
 		ReceiverModuleAccounts: receiverModuleAccounts,
 		SenderModuleAccounts:   senderModuleAccounts,
+		authority:              authority,
 	}
 
 	return k
 }
 
+// SetTransferKeeper sets the IBC transfer keeper used to forward
+// SendToCosmosEvent deposits to other chains. It is set after construction
+// because the transfer keeper depends on IBC keepers created after gravity.
+func (k *Keeper) SetTransferKeeper(tk types.TransferKeeper) *Keeper {
+	if k.transferKeeper != nil {
+		panic("cannot set gravity transfer keeper twice")
+	}
+
+	k.transferKeeper = tk
+
+	return k
+}
+
+// GetAuthority returns the address capable of executing governance messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
@@ -88,13 +118,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) incrementLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
 	current := k.GetLatestSignerSetTxNonce(ctx)
 	next := current + 1
-	ctx.KVStore(k.storeKey).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(next))
+	k.setLatestSignerSetTxNonce(ctx, next)
 	return next
 }
 
+// setLatestSignerSetTxNonce sets the latest valset nonce
+func (k Keeper) setLatestSignerSetTxNonce(ctx sdk.Context, nonce uint64) {
+	k.chainStore(ctx).Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(nonce))
+}
+
 // GetLatestSignerSetTxNonce returns the latest valset nonce
 func (k Keeper) GetLatestSignerSetTxNonce(ctx sdk.Context) uint64 {
-	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
+	if bz := k.chainStore(ctx).Get([]byte{types.LatestSignerSetTxNonceKey}); bz != nil {
 		return binary.BigEndian.Uint64(bz)
 	}
 	return 0
@@ -132,21 +167,35 @@ func (k Keeper) GetLastUnbondingBlockHeight(ctx sdk.Context) uint64 {
 
 // getEthereumSignature returns an ethereum signature by a nonce and validator address
 func (k Keeper) getEthereumSignature(ctx sdk.Context, storeIndex []byte, validator sdk.ValAddress) []byte {
-	return ctx.KVStore(k.storeKey).Get(types.MakeEthereumSignatureKey(storeIndex, validator))
+	bz := k.chainStore(ctx).Get(types.MakeEthereumSignatureKey(storeIndex, validator))
+	if bz == nil {
+		return nil
+	}
+	return k.unmarshalEthereumSignature(bz).Signature
 }
 
-// SetEthereumSignature sets an ethereum signature
+// SetEthereumSignature sets an ethereum signature, together with the ethereum
+// address of its signer
 func (k Keeper) SetEthereumSignature(ctx sdk.Context, sig types.EthereumTxConfirmation, val sdk.ValAddress) []byte {
 	key := types.MakeEthereumSignatureKey(sig.GetStoreIndex(), val)
-	ctx.KVStore(k.storeKey).Set(key, sig.GetSignature())
+	k.chainStore(ctx).Set(key, k.cdc.MustMarshal(&types.EthereumTxSignature{
+		EthereumSigner: sig.GetSigner().Hex(),
+		Signature:      sig.GetSignature(),
+	}))
 	return key
 }
 
+func (k Keeper) unmarshalEthereumSignature(bz []byte) types.EthereumTxSignature {
+	var sig types.EthereumTxSignature
+	k.cdc.MustUnmarshal(bz, &sig)
+	return sig
+}
+
 // GetEthereumSignatures returns all etherum signatures for a given outgoing tx by store index
 func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[string][]byte {
 	var signatures = make(map[string][]byte)
-	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, h []byte) bool {
-		signatures[val.String()] = h
+	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, sig types.EthereumTxSignature) bool {
+		signatures[val.String()] = sig.Signature
 		return false
 	})
 	return signatures
@@ -155,32 +204,32 @@ func (k Keeper) GetEthereumSignatures(ctx sdk.Context, storeIndex []byte) map[st
 // DeleteEthereumSignatures deletes all ethereum signatures for a given outgoing tx by store index
 func (k Keeper) DeleteEthereumSignatures(ctx sdk.Context, storeIndex []byte) {
 	var keys [][]byte
-	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, h []byte) bool {
+	k.iterateEthereumSignatures(ctx, storeIndex, func(val sdk.ValAddress, _ types.EthereumTxSignature) bool {
 		keys = append(keys, types.MakeEthereumSignatureKey(storeIndex, val))
 		return false
 	})
 
 	for _, key := range keys {
-		ctx.KVStore(k.storeKey).Delete(key)
+		k.chainStore(ctx).Delete(key)
 	}
 }
 
 // iterateEthereumSignatures iterates through all valset confirms by nonce in ASC order
-func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, []byte) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, storeIndex...))
+func (k Keeper) iterateEthereumSignatures(ctx sdk.Context, storeIndex []byte, cb func(sdk.ValAddress, types.EthereumTxSignature) bool) {
+	prefixStore := prefix.NewStore(k.chainStore(ctx), append([]byte{types.EthereumSignatureKey}, storeIndex...))
 	iter := prefixStore.Iterator(nil, nil)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		// cb returns true to stop early
-		if cb(iter.Key(), iter.Value()) {
+		if cb(iter.Key(), k.unmarshalEthereumSignature(iter.Value())) {
 			break
 		}
 	}
 }
 
-func (k Keeper) IterateBatchTxEthereumSignatures(ctx sdk.Context, cb func(common.Address, uint64, sdk.ValAddress, []byte) bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) IterateBatchTxEthereumSignatures(ctx sdk.Context, cb func(common.Address, uint64, sdk.ValAddress, types.EthereumTxSignature) bool) {
+	store := k.chainStore(ctx)
 	iter := sdk.KVStorePrefixIterator(store, []byte{types.EthereumSignatureKey, types.BatchTxPrefixByte})
 	defer iter.Close()
 
@@ -191,14 +240,14 @@ func (k Keeper) IterateBatchTxEthereumSignatures(ctx sdk.Context, cb func(common
 		nonce := binary.BigEndian.Uint64(key.Next(8))
 		val := sdk.ValAddress(key.Next(20))
 
-		if cb(contractAddr, nonce, val, iter.Value()) {
+		if cb(contractAddr, nonce, val, k.unmarshalEthereumSignature(iter.Value())) {
 			break
 		}
 	}
 }
 
-func (k Keeper) IterateContractCallTxEthereumSignatures(ctx sdk.Context, cb func([]byte, uint64, sdk.ValAddress, []byte) bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) IterateContractCallTxEthereumSignatures(ctx sdk.Context, cb func([]byte, uint64, sdk.ValAddress, types.EthereumTxSignature) bool) {
+	store := k.chainStore(ctx)
 	iter := sdk.KVStorePrefixIterator(store, []byte{types.EthereumSignatureKey, types.ContractCallTxPrefixByte})
 	defer iter.Close()
 
@@ -209,14 +258,14 @@ func (k Keeper) IterateContractCallTxEthereumSignatures(ctx sdk.Context, cb func
 		nonce := binary.BigEndian.Uint64(key.Next(8))
 		val := sdk.ValAddress(key.Next(20))
 
-		if cb(invalidationScope, nonce, val, iter.Value()) {
+		if cb(invalidationScope, nonce, val, k.unmarshalEthereumSignature(iter.Value())) {
 			break
 		}
 	}
 }
 
-func (k Keeper) IterateSignerSetTxEthereumSignatures(ctx sdk.Context, cb func(uint64, sdk.ValAddress, []byte) bool) {
-	store := ctx.KVStore(k.storeKey)
+func (k Keeper) IterateSignerSetTxEthereumSignatures(ctx sdk.Context, cb func(uint64, sdk.ValAddress, types.EthereumTxSignature) bool) {
+	store := k.chainStore(ctx)
 	iter := sdk.KVStorePrefixIterator(store, []byte{types.EthereumSignatureKey, types.SignerSetTxPrefixByte})
 	defer iter.Close()
 
@@ -226,7 +275,7 @@ func (k Keeper) IterateSignerSetTxEthereumSignatures(ctx sdk.Context, cb func(ui
 		nonce := binary.BigEndian.Uint64(key.Next(8))
 		val := sdk.ValAddress(key.Next(20))
 
-		if cb(nonce, val, iter.Value()) {
+		if cb(nonce, val, k.unmarshalEthereumSignature(iter.Value())) {
 			break
 		}
 	}
@@ -253,6 +302,10 @@ func (k Keeper) GetOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.Ac
 	return store.Get(key)
 }
 
+func (k Keeper) deleteOrchestratorValidatorAddress(ctx sdk.Context, orchAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeOrchestratorValidatorAddressKey(orchAddr))
+}
+
 ////////////////////////
 // VAL -> ETH ADDRESS //
 ////////////////////////
@@ -306,6 +359,10 @@ func (k Keeper) GetEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.A
 	return store.Get(key)
 }
 
+func (k Keeper) deleteEthereumOrchestratorAddress(ctx sdk.Context, ethAddr common.Address) {
+	ctx.KVStore(k.storeKey).Delete(types.MakeEthereumOrchestratorAddressKey(ethAddr))
+}
+
 func (k Keeper) ethAddressForOrchestratorExists(ctx sdk.Context, orch sdk.AccAddress) bool {
 	store := ctx.KVStore(k.storeKey)
 	iter := prefix.NewStore(store, []byte{types.EthereumOrchestratorAddressKey}).Iterator(nil, nil)
@@ -342,6 +399,7 @@ func (k Keeper) CreateSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 		"height", newSignerSetTx.Height,
 		"signers", len(newSignerSetTx.Signers),
 	)
+	k.AfterSignerSetTxCreated(ctx, *newSignerSetTx)
 	return newSignerSetTx
 }
 
@@ -395,27 +453,28 @@ func (k Keeper) GetSignerSetTxs(ctx sdk.Context) (out []*types.SignerSetTx) {
 
 // GetParams returns the parameters from the store
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return
+	bz := k.chainStore(ctx).Get([]byte{types.ParamsKey})
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
 
 // SetParams sets the parameters in the store
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
-	k.paramSpace.SetParamSet(ctx, &ps)
+	k.chainStore(ctx).Set([]byte{types.ParamsKey}, k.cdc.MustMarshal(&ps))
 }
 
 // getBridgeContractAddress returns the bridge contract address on ETH
 func (k Keeper) getBridgeContractAddress(ctx sdk.Context) string {
-	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractAddress, &a)
-	return a
+	return k.GetParams(ctx).BridgeEthereumAddress
 }
 
 // getBridgeChainID returns the chain id of the ETH chain we are running against
 func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
-	var a uint64
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyBridgeContractChainID, &a)
-	return a
+	return k.GetParams(ctx).BridgeChainId
 }
 
 // getGravityID returns the GravityID the GravityID is essentially a salt value
@@ -429,9 +488,7 @@ func (k Keeper) getBridgeChainID(ctx sdk.Context) uint64 {
 // same as the chain id since the chain id may be changed many times with each
 // successive chain in charge of the same bridge
 func (k Keeper) getGravityID(ctx sdk.Context) string {
-	var a string
-	k.paramSpace.Get(ctx, types.ParamsStoreKeyGravityID, &a)
-	return a
+	return k.GetParams(ctx).GravityId
 }
 
 // getDelegateKeys iterates both the EthAddress and Orchestrator address indexes to produce
@@ -495,7 +552,7 @@ func (k Keeper) getTimeoutHeight(ctx sdk.Context) uint64 {
 
 // GetOutgoingTx todo: outgoingTx prefix byte
 func (k Keeper) GetOutgoingTx(ctx sdk.Context, storeIndex []byte) (out types.OutgoingTx) {
-	if err := k.cdc.UnmarshalInterface(ctx.KVStore(k.storeKey).Get(types.MakeOutgoingTxKey(storeIndex)), &out); err != nil {
+	if err := k.cdc.UnmarshalInterface(k.chainStore(ctx).Get(types.MakeOutgoingTxKey(storeIndex)), &out); err != nil {
 		panic(err)
 	}
 	return out
@@ -506,7 +563,7 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 	if err != nil {
 		panic(err)
 	}
-	ctx.KVStore(k.storeKey).Set(
+	k.chainStore(ctx).Set(
 		types.MakeOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
@@ -514,11 +571,11 @@ func (k Keeper) SetOutgoingTx(ctx sdk.Context, outgoing types.OutgoingTx) {
 
 // DeleteOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteOutgoingTx(ctx sdk.Context, storeIndex []byte) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeOutgoingTxKey(storeIndex))
+	k.chainStore(ctx).Delete(types.MakeOutgoingTxKey(storeIndex))
 }
 
 func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRequest, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) bool) (*query.PageResponse, error) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+	prefixStore := prefix.NewStore(k.chainStore(ctx), types.MakeOutgoingTxKey([]byte{prefixByte}))
 
 	return query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if !accumulate {
@@ -541,7 +598,7 @@ func (k Keeper) PaginateOutgoingTxsByType(ctx sdk.Context, pageReq *query.PageRe
 
 // IterateOutgoingTxsByType iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
 func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeOutgoingTxKey([]byte{prefixByte}))
+	prefixStore := prefix.NewStore(k.chainStore(ctx), types.MakeOutgoingTxKey([]byte{prefixByte}))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -559,7 +616,7 @@ func (k Keeper) IterateOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb fu
 
 // iterateOutgoingTxs iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
 func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing types.OutgoingTx) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.OutgoingTxKey})
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -578,7 +635,7 @@ func (k Keeper) iterateOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing
 // GetLastObservedSignerSetTx retrieves the last observed validator set from the store
 func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 	key := []byte{types.LastObservedSignerSetKey}
-	if val := ctx.KVStore(k.storeKey).Get(key); val != nil {
+	if val := k.chainStore(ctx).Get(key); val != nil {
 		var out types.SignerSetTx
 		k.cdc.MustUnmarshal(val, &out)
 		return &out
@@ -589,12 +646,22 @@ func (k Keeper) GetLastObservedSignerSetTx(ctx sdk.Context) *types.SignerSetTx {
 // setLastObservedSignerSetTx updates the last observed validator set in the stor e
 func (k Keeper) setLastObservedSignerSetTx(ctx sdk.Context, signerSet types.SignerSetTx) {
 	key := []byte{types.LastObservedSignerSetKey}
-	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&signerSet))
+	k.chainStore(ctx).Set(key, k.cdc.MustMarshal(&signerSet))
 }
 
-// CreateContractCallTx xxx
+// CreateContractCallTx creates a contract call tx and returns it, or returns nil while a bridge contract migration is
+// in progress or the bridge is paused, as new outbound traffic is frozen until the bridge is on a trusted contract
 func (k Keeper) CreateContractCallTx(ctx sdk.Context, invalidationNonce uint64, invalidationScope tmbytes.HexBytes,
 	address common.Address, payload []byte, tokens []types.ERC20Token, fees []types.ERC20Token) *types.ContractCallTx {
+	if err := k.checkBridgeNotMigrating(ctx); err != nil {
+		k.Logger(ctx).Info("not creating contract call tx", "error", err)
+		return nil
+	}
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		k.Logger(ctx).Info("not creating contract call tx", "error", err)
+		return nil
+	}
+
 	params := k.GetParams(ctx)
 
 	newContractCallTx := &types.ContractCallTx{
@@ -663,7 +730,7 @@ func (k Keeper) SetCompletedOutgoingTx(ctx sdk.Context, outgoing types.OutgoingT
 	if err != nil {
 		panic(err)
 	}
-	ctx.KVStore(k.storeKey).Set(
+	k.chainStore(ctx).Set(
 		types.MakeCompletedOutgoingTxKey(outgoing.GetStoreIndex()),
 		k.cdc.MustMarshal(any),
 	)
@@ -671,7 +738,7 @@ func (k Keeper) SetCompletedOutgoingTx(ctx sdk.Context, outgoing types.OutgoingT
 
 // GetCompletedOutgoingTx gets a completed outgoing tx
 func (k Keeper) GetCompletedOutgoingTx(ctx sdk.Context, storeIndex []byte) (out types.OutgoingTx) {
-	if err := k.cdc.UnmarshalInterface(ctx.KVStore(k.storeKey).Get(types.MakeCompletedOutgoingTxKey(storeIndex)), &out); err != nil {
+	if err := k.cdc.UnmarshalInterface(k.chainStore(ctx).Get(types.MakeCompletedOutgoingTxKey(storeIndex)), &out); err != nil {
 		panic(err)
 	}
 	return out
@@ -679,7 +746,7 @@ func (k Keeper) GetCompletedOutgoingTx(ctx sdk.Context, storeIndex []byte) (out
 
 // IterateOutgoingTxs iterates over a specific type of outgoing transaction denoted by the chosen prefix byte
 func (k Keeper) IterateCompletedOutgoingTxs(ctx sdk.Context, cb func(key []byte, outgoing types.OutgoingTx) bool) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.CompletedOutgoingTxKey})
+	prefixStore := prefix.NewStore(k.chainStore(ctx), []byte{types.CompletedOutgoingTxKey})
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -697,7 +764,7 @@ func (k Keeper) IterateCompletedOutgoingTxs(ctx sdk.Context, cb func(key []byte,
 
 // IterateCompletedOutgoingTxsByType iterates over a specific type of completed outgoing transaction denoted by the chosen prefix byte
 func (k Keeper) IterateCompletedOutgoingTxsByType(ctx sdk.Context, prefixByte byte, cb func(key []byte, outgoing types.OutgoingTx) (stop bool)) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MakeCompletedOutgoingTxKey([]byte{prefixByte}))
+	prefixStore := prefix.NewStore(k.chainStore(ctx), types.MakeCompletedOutgoingTxKey([]byte{prefixByte}))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
@@ -716,17 +783,17 @@ func (k Keeper) IterateCompletedOutgoingTxsByType(ctx sdk.Context, prefixByte by
 // DeleteCompletedOutgoingTx deletes a given outgoingtx
 func (k Keeper) DeleteCompletedOutgoingTx(ctx sdk.Context, storeIndex []byte) {
 	k.DeleteEthereumSignatures(ctx, storeIndex)
-	ctx.KVStore(k.storeKey).Delete(types.MakeCompletedOutgoingTxKey(storeIndex))
+	k.chainStore(ctx).Delete(types.MakeCompletedOutgoingTxKey(storeIndex))
 }
 
 // SetLastSlashedOutgoingTxBlockHeight sets the latest slashed Batch block height
 func (k Keeper) SetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context, blockHeight uint64) {
-	ctx.KVStore(k.storeKey).Set([]byte{types.LastSlashedOutgoingTxBlockKey}, sdk.Uint64ToBigEndian(blockHeight))
+	k.chainStore(ctx).Set([]byte{types.LastSlashedOutgoingTxBlockKey}, sdk.Uint64ToBigEndian(blockHeight))
 }
 
 // GetLastSlashedOutgoingTxBlockHeight returns the latest slashed Batch block
 func (k Keeper) GetLastSlashedOutgoingTxBlockHeight(ctx sdk.Context) uint64 {
-	if bz := ctx.KVStore(k.storeKey).Get([]byte{types.LastSlashedOutgoingTxBlockKey}); bz == nil {
+	if bz := k.chainStore(ctx).Get([]byte{types.LastSlashedOutgoingTxBlockKey}); bz == nil {
 		return 0
 	} else {
 		return binary.BigEndian.Uint64(bz)
@@ -756,7 +823,7 @@ func (k Keeper) GetUnslashedOutgoingTxs(ctx sdk.Context, maxHeight uint64) (out
 
 // GetEthereumHeightVoteRecord gets the latest observed heights per validator
 func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress) types.LatestEthereumBlockHeight {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	key := types.MakeEthereumHeightVoteKey(valAddress)
 	bytes := store.Get(key)
 
@@ -774,17 +841,19 @@ func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress
 
 // SetEthereumHeightVoteRecord sets the latest observed heights per validator
 func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
-	height := types.LatestEthereumBlockHeight{
+	k.setEthereumHeightVote(ctx, valAddress, types.LatestEthereumBlockHeight{
 		EthereumHeight: ethereumHeight,
 		CosmosHeight:   uint64(ctx.BlockHeight()),
-	}
-	key := types.MakeEthereumHeightVoteKey(valAddress)
-	store.Set(key, k.cdc.MustMarshal(&height))
+	})
+}
+
+// setEthereumHeightVote sets the latest observed heights of a validator, keeping the cosmos height they were observed at
+func (k Keeper) setEthereumHeightVote(ctx sdk.Context, valAddress sdk.ValAddress, height types.LatestEthereumBlockHeight) {
+	k.chainStore(ctx).Set(types.MakeEthereumHeightVoteKey(valAddress), k.cdc.MustMarshal(&height))
 }
 
 func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func(val sdk.ValAddress, height types.LatestEthereumBlockHeight) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	iter := sdk.KVStorePrefixIterator(store, []byte{types.EthereumHeightVoteKey})
 	defer iter.Close()
 
@@ -804,14 +873,14 @@ func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func(val sdk.ValA
 // MIGRATE     //
 /////////////////
 
-// Clean up all state associated a previous gravity contract and set a new contract. This is intended to run in the upgrade handler.
-// This implementation is partial at best. It doees not contain necessary functionality to freeze the bridge.
-// We will have yet to implement functionality to Migrate the Cosmos ERC20 tokens or any other ERC20 tokens bridged to the gravity contracts.
-// This just does keeper state cleanup if a new gravity contract has been deployed
+// MigrateGravityContract cleans up all state associated with the previous gravity contract, resets the bridge nonces and
+// sets the new contract. It does not freeze the bridge nor refund anything, whatever is still in flight is dropped:
+// it is the last step of a MsgMigrateBridgeContract migration, which runs it once the bridge has been drained.
+// Migrating the Cosmos ERC20 tokens or any other ERC20 tokens held by the previous gravity contract is out of scope.
 func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string, bridgeDeploymentHeight uint64) {
 	// Delete Any Outgoing TXs.
 
-	prefixStoreOtx := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.OutgoingTxKey})
+	prefixStoreOtx := prefix.NewStore(k.chainStore(ctx), []byte{types.OutgoingTxKey})
 	iterOtx := prefixStoreOtx.ReverseIterator(nil, nil)
 	defer iterOtx.Close()
 	for ; iterOtx.Valid(); iterOtx.Next() {
@@ -823,7 +892,7 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 			panic(err)
 		}
 		// Delete any partial Eth Signatures handging around
-		prefixStoreSig := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.EthereumSignatureKey}, otx.GetStoreIndex()...))
+		prefixStoreSig := prefix.NewStore(k.chainStore(ctx), append([]byte{types.EthereumSignatureKey}, otx.GetStoreIndex()...))
 		iterSig := prefixStoreSig.Iterator(nil, nil)
 		defer iterSig.Close()
 
@@ -834,28 +903,31 @@ func (k Keeper) MigrateGravityContract(ctx sdk.Context, newBridgeAddress string,
 		prefixStoreOtx.Delete(iterOtx.Key())
 	}
 
+	// Delete the completed outgoing txs and their signatures, as the reset nonces would collide with their store indexes
+	var completedStoreIndexes [][]byte
+	k.IterateCompletedOutgoingTxs(ctx, func(_ []byte, cotx types.OutgoingTx) bool {
+		completedStoreIndexes = append(completedStoreIndexes, cotx.GetStoreIndex())
+		return false
+	})
+	for _, storeIndex := range completedStoreIndexes {
+		k.DeleteCompletedOutgoingTx(ctx, storeIndex)
+	}
+
 	// Reset the last observed signer set nonce
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx)
 	store.Set([]byte{types.LatestSignerSetTxNonceKey}, sdk.Uint64ToBigEndian(0))
 
 	// Reset all ethereum event nonces to zero
 	k.setLastObservedEventNonce(ctx, 0)
-	k.IterateEthereumEventVoteRecords(ctx, func(_ []byte, voteRecord *types.EthereumEventVoteRecord) bool {
-		for _, vote := range voteRecord.Votes {
-			val, err := sdk.ValAddressFromBech32(vote)
-
-			if err != nil {
-				panic(err)
-			}
-
-			k.setLastEventNonceByValidator(ctx, val, 0)
-		}
-
-		return false
-	})
+	prefixStoreEventNonce := prefix.NewStore(k.chainStore(ctx), []byte{types.LastEventNonceByValidatorKey})
+	iterEventNonce := prefixStoreEventNonce.Iterator(nil, nil)
+	defer iterEventNonce.Close()
+	for ; iterEventNonce.Valid(); iterEventNonce.Next() {
+		prefixStoreEventNonce.Set(iterEventNonce.Key(), sdk.Uint64ToBigEndian(0))
+	}
 
 	// Delete all Ethereum Events
-	prefixStoreEthereumEvent := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.EthereumEventVoteRecordKey})
+	prefixStoreEthereumEvent := prefix.NewStore(k.chainStore(ctx), []byte{types.EthereumEventVoteRecordKey})
 	iterEvent := prefixStoreEthereumEvent.Iterator(nil, nil)
 	defer iterEvent.Close()
 	for ; iterEvent.Valid(); iterEvent.Next() {
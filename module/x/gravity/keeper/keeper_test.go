@@ -696,13 +696,13 @@ func TestEthereumSignatureIterators(t *testing.T) {
 
 	iterationCount := 0
 	var batchSigs []*types.BatchTxConfirmation
-	k.IterateBatchTxEthereumSignatures(ctx, func(contractAddr common.Address, nonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateBatchTxEthereumSignatures(ctx, func(contractAddr common.Address, nonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		iterationCount++
 		batchSigs = append(batchSigs, &types.BatchTxConfirmation{
 			TokenContract:  contractAddr.Hex(),
 			BatchNonce:     nonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, valAddr).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -732,13 +732,13 @@ func TestEthereumSignatureIterators(t *testing.T) {
 
 	iterationCount = 0
 	var ccSigs []*types.ContractCallTxConfirmation
-	k.IterateContractCallTxEthereumSignatures(ctx, func(invalidationScope []byte, invalidationNonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateContractCallTxEthereumSignatures(ctx, func(invalidationScope []byte, invalidationNonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		iterationCount++
 		ccSigs = append(ccSigs, &types.ContractCallTxConfirmation{
 			InvalidationScope: invalidationScope,
 			InvalidationNonce: invalidationNonce,
-			EthereumSigner:    k.GetValidatorEthereumAddress(ctx, valAddr).Hex(),
-			Signature:         sig,
+			EthereumSigner:    sig.EthereumSigner,
+			Signature:         sig.Signature,
 		})
 		return false
 	})
@@ -765,12 +765,12 @@ func TestEthereumSignatureIterators(t *testing.T) {
 
 	iterationCount = 0
 	var ssSigs []*types.SignerSetTxConfirmation
-	k.IterateSignerSetTxEthereumSignatures(ctx, func(nonce uint64, val sdk.ValAddress, sig []byte) bool {
+	k.IterateSignerSetTxEthereumSignatures(ctx, func(nonce uint64, val sdk.ValAddress, sig types.EthereumTxSignature) bool {
 		iterationCount++
 		ssSigs = append(ssSigs, &types.SignerSetTxConfirmation{
 			SignerSetNonce: nonce,
-			EthereumSigner: k.GetValidatorEthereumAddress(ctx, valAddr).Hex(),
-			Signature:      sig,
+			EthereumSigner: sig.EthereumSigner,
+			Signature:      sig.Signature,
 		})
 		return false
 	})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
@@ -29,6 +30,62 @@ func (m Migrator) MigrateStore(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateParams moves the gravity params from the legacy x/params subspace into
+// the gravity store, after which they are only changed through MsgUpdateParams
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	ctx.Logger().Info("gravity: Migrating params from the legacy subspace")
+
+	// params that were never part of the legacy subspace keep their defaults
+	params := types.DefaultParams()
+	m.keeper.legacySubspace.GetParamSet(ctx, params)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, *params)
+
+	return nil
+}
+
+// MigrateEVMChains moves the chain scoped state of the single chain bridge
+// under the prefix of its bridge chain id and registers it as the default EVM
+// chain. The delegate keys are shared by all chains and stay in place.
+func (m Migrator) MigrateEVMChains(ctx sdk.Context) error {
+	evmChainID := m.keeper.GetParams(ctx).BridgeChainId
+	ctx.Logger().Info("gravity: Moving the bridge state under its evm chain", "evm_chain_id", evmChainID)
+
+	type entry struct {
+		key, value []byte
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	var entries []entry
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if types.IsEVMChainKey(iter.Key()) {
+			entries = append(entries, entry{key: iter.Key(), value: iter.Value()})
+		}
+	}
+	iter.Close()
+
+	chainStore := prefix.NewStore(store, types.MakeEVMChainStoreKey(evmChainID))
+	for _, e := range entries {
+		// the signatures are now stored with their signer, which is the current
+		// ethereum key of the validator as the keys could not be rotated before
+		if e.key[0] == types.EthereumSignatureKey {
+			val := sdk.ValAddress(e.key[len(e.key)-20:])
+			e.value = m.keeper.cdc.MustMarshal(&types.EthereumTxSignature{
+				EthereumSigner: m.keeper.GetValidatorEthereumAddress(ctx, val).Hex(),
+				Signature:      e.value,
+			})
+		}
+		chainStore.Set(e.key, e.value)
+		store.Delete(e.key)
+	}
+	m.keeper.setDefaultEVMChain(ctx, evmChainID)
+
+	return nil
+}
+
 // DeletePendingEventVoteRecords deletes pending event vote records and adjusts the last observed nonce for validators
 // who voted on unapproved events. This upgrade includes changes to how event hashes are calculated, so we delete
 // pending event vote records that were created with the old hash calculation method to prevent inconsistent hashes.
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 	"github.com/stretchr/testify/require"
 )
@@ -333,3 +334,60 @@ func TestDeletePendingEventVoteRecordsInactiveValidators(t *testing.T) {
 	require.Equal(t, uint64(5), gk.getLastEventNonceByValidator(env.Context, inactiveVal1))
 	require.Equal(t, uint64(7), gk.getLastEventNonceByValidator(env.Context, inactiveVal2))
 }
+
+func TestMigrateParams(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	params := gk.GetParams(ctx)
+	params.GravityId = "legacy"
+	gk.legacySubspace.SetParamSet(ctx, &params)
+	ctx.KVStore(gk.storeKey).Delete([]byte{types.ParamsKey})
+	require.Equal(t, types.Params{}, gk.GetParams(ctx))
+
+	require.NoError(t, NewMigrator(gk).MigrateParams(ctx))
+	require.Equal(t, params, gk.GetParams(ctx))
+	require.Equal(t, "legacy", gk.getGravityID(ctx))
+}
+
+func TestMigrateEVMChains(t *testing.T) {
+	env := CreateTestEnv(t)
+	ctx := env.Context
+	gk := env.GravityKeeper
+
+	valAddr := sdk.ValAddress([]byte("validator_address___"))
+	ethAddr := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	gk.setValidatorEthereumAddress(ctx, valAddr, ethAddr)
+
+	signerSet := types.NewSignerSetTx(3, 10, types.EthereumSigners{{Power: 100, EthereumAddress: ethAddr.Hex()}})
+	gk.SetOutgoingTx(ctx, signerSet)
+	params := gk.GetParams(ctx)
+
+	store := ctx.KVStore(gk.storeKey)
+	otxKey := types.MakeOutgoingTxKey(signerSet.GetStoreIndex())
+	require.True(t, store.Has(otxKey))
+	// the signatures were stored without their signer
+	store.Set(types.MakeEthereumSignatureKey(signerSet.GetStoreIndex(), valAddr), []byte("signature"))
+
+	require.NoError(t, NewMigrator(gk).MigrateEVMChains(ctx))
+
+	// the chain state moved under the bridge chain id, the delegate keys stayed
+	require.False(t, store.Has(otxKey))
+	require.False(t, store.Has([]byte{types.ParamsKey}))
+	require.True(t, store.Has(append(types.MakeEVMChainStoreKey(params.BridgeChainId), otxKey...)))
+	require.True(t, store.Has(types.MakeValidatorEthereumAddressKey(valAddr)))
+
+	require.Equal(t, params.BridgeChainId, gk.GetDefaultEVMChainID(ctx))
+	require.Equal(t, []uint64{params.BridgeChainId}, gk.GetEVMChainIDs(ctx))
+	require.Equal(t, params, gk.GetParams(ctx))
+	require.Equal(t, signerSet, gk.GetOutgoingTx(ctx, signerSet.GetStoreIndex()))
+	require.Equal(t, ethAddr, gk.GetValidatorEthereumAddress(ctx, valAddr))
+
+	var signatures []types.EthereumTxSignature
+	gk.iterateEthereumSignatures(ctx, signerSet.GetStoreIndex(), func(_ sdk.ValAddress, sig types.EthereumTxSignature) bool {
+		signatures = append(signatures, sig)
+		return false
+	})
+	require.Equal(t, []types.EthereumTxSignature{{EthereumSigner: ethAddr.Hex(), Signature: []byte("signature")}}, signatures)
+}
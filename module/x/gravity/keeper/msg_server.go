@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -27,6 +28,13 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
+// forEVMChain returns the msg server scoped to the EVM chain selected by a
+// message, chain id 0 selecting the default chain
+func (k msgServer) forEVMChain(ctx sdk.Context, evmChainID uint64) (msgServer, error) {
+	ck, err := k.evmChainKeeper(ctx, evmChainID)
+	return msgServer{Keeper: ck}, err
+}
+
 func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys) (*types.MsgDelegateKeysResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -53,31 +61,16 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 	}
 
 	// check if the orchestrator address is currently not used
-	if k.ethAddressForOrchestratorExists(ctx, orchAddr) {
+	if k.orchestratorInUse(ctx, orchAddr) {
 		return nil, errors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
 	}
 
-	valAccAddr := sdk.AccAddress(valAddr)
-	valAccSeq, err := k.accountKeeper.GetSequence(ctx, valAccAddr)
+	nonce, err := k.validatorAccountNonce(ctx, valAddr)
 	if err != nil {
-		return nil, errors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", valAccAddr)
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", sdk.AccAddress(valAddr))
 	}
 
-	var nonce uint64
-	if valAccSeq > 0 {
-		nonce = valAccSeq - 1
-	}
-
-	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
-		ValidatorAddress: valAddr.String(),
-		// We decrement since we process the message after the ante-handler which
-		// increments the nonce.
-		Nonce: nonce,
-	})
-
-	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
-
-	if err = types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr); err != nil {
+	if err = k.validateDelegateKeysSignature(ctx, msg, valAddr, ethAddr, nonce); err != nil {
 		return nil, errors.Wrapf(
 			types.ErrDelegateKeys,
 			"failed to validate delegate keys signature for Ethereum address %X; %s ;%d",
@@ -103,10 +96,191 @@ func (k msgServer) SetDelegateKeys(c context.Context, msg *types.MsgDelegateKeys
 
 }
 
+// validateDelegateKeysSignature checks the signature of the ethereum key of
+// MsgDelegateKeys, over the proto encoded DelegateKeysSignMsg or over the
+// DelegateKeys EIP-712 typed data in the domain of the default EVM chain
+func (k msgServer) validateDelegateKeysSignature(ctx sdk.Context, msg *types.MsgDelegateKeys, valAddr sdk.ValAddress, ethAddr common.Address, nonce uint64) error {
+	if msg.SignatureVersion == types.DelegateKeysSignatureVersionEIP712 {
+		hash, err := types.DelegateKeysTypedDataHash(valAddr.String(), nonce, k.GetParams(ctx).BridgeChainId)
+		if err != nil {
+			return err
+		}
+		return types.ValidateEIP712Signature(hash, msg.EthSignature, ethAddr)
+	}
+
+	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysSignMsg{
+		ValidatorAddress: valAddr.String(),
+		Nonce:            nonce,
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	return types.ValidateEthereumSignature(hash, msg.EthSignature, ethAddr)
+}
+
+// RotateDelegateKeys handles MsgRotateDelegateKeys. It replaces the ethereum
+// and orchestrator addresses of a validator that already set its delegate keys,
+// once both the current and the new ethereum keys have signed the rotation.
+// A validator can only rotate its keys once per rotation cooldown. Rotating
+// the ethereum key creates a new signer set tx in the next block so that the
+// Gravity contract learns the new key.
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidatorAddress, err.Error())
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidOrchestratorAddress, err.Error())
+	}
+
+	ethAddr := common.HexToAddress(msg.EthereumAddress)
+
+	oldEthAddr := k.GetValidatorEthereumAddress(ctx, valAddr)
+	if oldEthAddr == (common.Address{}) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "validator %s has no delegate keys to rotate", valAddr)
+	}
+	oldOrchAddr := k.GetEthereumOrchestratorAddress(ctx, oldEthAddr)
+
+	if ethAddr == oldEthAddr && orchAddr.Equals(oldOrchAddr) {
+		return nil, errors.Wrap(types.ErrDelegateKeys, "delegate keys are unchanged")
+	}
+
+	// check that the new addresses are not used by another validator
+	if ethAddr != oldEthAddr && k.validatorForEthAddressExists(ctx, ethAddr) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "ethereum address %s in use", ethAddr)
+	}
+	if !orchAddr.Equals(oldOrchAddr) && k.orchestratorInUse(ctx, orchAddr) && !k.hasAdditionalOrchestrator(ctx, valAddr, orchAddr) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	cooldown := k.GetParams(ctx).DelegateKeysRotationCooldown
+	if lastRotation := k.GetDelegateKeysRotationHeight(ctx, valAddr); lastRotation > 0 && uint64(ctx.BlockHeight()) < lastRotation+cooldown {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "delegate keys were rotated at height %d, next rotation allowed at height %d", lastRotation, lastRotation+cooldown)
+	}
+
+	nonce, err := k.validatorAccountNonce(ctx, valAddr)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "failed to get sequence for validator account %s", sdk.AccAddress(valAddr))
+	}
+
+	signMsgBz := k.cdc.MustMarshal(&types.DelegateKeysRotationSignMsg{
+		ValidatorAddress:    valAddr.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr.Hex(),
+		Nonce:               nonce,
+	})
+	hash := crypto.Keccak256Hash(signMsgBz).Bytes()
+
+	if err = types.ValidateEthereumSignature(hash, msg.OldEthSignature, oldEthAddr); err != nil {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "failed to validate rotation signature of current Ethereum address %s; %s", oldEthAddr, err)
+	}
+	if err = types.ValidateEthereumSignature(hash, msg.NewEthSignature, ethAddr); err != nil {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "failed to validate rotation signature of new Ethereum address %s; %s", ethAddr, err)
+	}
+
+	k.rotateDelegateKeys(ctx, valAddr, oldEthAddr, oldOrchAddr, ethAddr, orchAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySetEthereumAddr, ethAddr.Hex()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgRotateDelegateKeysResponse{}, nil
+}
+
+// AddOrchestrator handles MsgAddOrchestrator. The orchestrator account is
+// accepted as a signer for the validator alongside the orchestrator of its
+// delegate keys. Votes and confirmations are still recorded per validator, so
+// the same event or confirmation submitted by two orchestrators of a validator
+// is only counted once.
+func (k msgServer) AddOrchestrator(c context.Context, msg *types.MsgAddOrchestrator) (*types.MsgAddOrchestratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidatorAddress, err.Error())
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidOrchestratorAddress, err.Error())
+	}
+
+	if k.GetValidatorEthereumAddress(ctx, valAddr) == (common.Address{}) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "validator %s has no delegate keys", valAddr)
+	}
+
+	if k.orchestratorInUse(ctx, orchAddr) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "orchestrator address %s in use", orchAddr)
+	}
+
+	if len(k.GetAdditionalOrchestrators(ctx, valAddr)) >= types.MaxAdditionalOrchestrators {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "validator %s already has %d additional orchestrators", valAddr, types.MaxAdditionalOrchestrators)
+	}
+
+	k.setAdditionalOrchestrator(ctx, valAddr, orchAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgAddOrchestratorResponse{}, nil
+}
+
+// RemoveOrchestrator handles MsgRemoveOrchestrator
+func (k msgServer) RemoveOrchestrator(c context.Context, msg *types.MsgRemoveOrchestrator) (*types.MsgRemoveOrchestratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidatorAddress, err.Error())
+	}
+
+	orchAddr, err := sdk.AccAddressFromBech32(msg.OrchestratorAddress)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidOrchestratorAddress, err.Error())
+	}
+
+	if !k.hasAdditionalOrchestrator(ctx, valAddr, orchAddr) {
+		return nil, errors.Wrapf(types.ErrDelegateKeys, "%s is not an additional orchestrator of validator %s", orchAddr, valAddr)
+	}
+
+	k.removeAdditionalOrchestrator(ctx, valAddr, orchAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyRemovedOrchestratorAddr, orchAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, valAddr.String()),
+		),
+	)
+
+	return &types.MsgRemoveOrchestratorResponse{}, nil
+}
+
 // SubmitEthereumTxConfirmation handles MsgSubmitEthereumTxConfirmation
 func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.MsgSubmitEthereumTxConfirmation) (*types.MsgSubmitEthereumTxConfirmationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	confirmation, err := types.UnpackConfirmation(msg.Confirmation)
 	if err != nil {
 		return nil, err
@@ -176,6 +350,11 @@ func (k msgServer) SubmitEthereumTxConfirmation(c context.Context, msg *types.Ms
 func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEthereumEvent) (*types.MsgSubmitEthereumEventResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	event, err := types.UnpackEvent(msg.Event)
 	if err != nil {
 		return nil, err
@@ -209,6 +388,11 @@ func (k msgServer) SubmitEthereumEvent(c context.Context, msg *types.MsgSubmitEt
 // SendToEthereum handles MsgSendToEthereum
 func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereum) (*types.MsgSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
@@ -245,7 +429,12 @@ func (k msgServer) SendToEthereum(c context.Context, msg *types.MsgSendToEthereu
 func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelSendToEthereum) (*types.MsgCancelSendToEthereumResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	err := k.Keeper.cancelSendToEthereum(ctx, msg.Id, msg.Sender)
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.cancelSendToEthereum(ctx, msg.Id, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +459,11 @@ func (k msgServer) CancelSendToEthereum(c context.Context, msg *types.MsgCancelS
 func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEthereumHeightVote) (*types.MsgEthereumHeightVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
 	val, err := k.getSignerValidator(ctx, msg.Signer)
 	if err != nil {
 		return nil, err
@@ -280,6 +474,314 @@ func (k msgServer) SubmitEthereumHeightVote(c context.Context, msg *types.MsgEth
 	return &types.MsgEthereumHeightVoteResponse{}, nil
 }
 
+// ResyncEventNonce handles MsgResyncEventNonce. It moves the signer's validator
+// last event nonce forward to the last observed event nonce so an orchestrator
+// that has fallen behind can resume voting without replaying every event. The
+// nonce is only ever moved forward; event vote records are left untouched.
+func (k msgServer) ResyncEventNonce(c context.Context, msg *types.MsgResyncEventNonce) (*types.MsgResyncEventNonceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	lastEventNonce := k.getLastEventNonceByValidator(ctx, val)
+	lastObservedEventNonce := k.GetLastObservedEventNonce(ctx)
+	if lastEventNonce >= lastObservedEventNonce {
+		return nil, errors.Wrapf(types.ErrInvalid,
+			"validator %s is not behind; last event nonce %d, last observed event nonce %d",
+			val, lastEventNonce, lastObservedEventNonce,
+		)
+	}
+
+	k.setLastEventNonceByValidator(ctx, val, lastObservedEventNonce)
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeEventNonceResynced,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousEventNonce, fmt.Sprint(lastEventNonce)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(lastObservedEventNonce)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+		),
+	})
+
+	return &types.MsgResyncEventNonceResponse{
+		PreviousEventNonce: lastEventNonce,
+		EventNonce:         lastObservedEventNonce,
+	}, nil
+}
+
+// RequestERC20Deployment handles MsgRequestERC20Deployment. It records the
+// ERC20 metadata expected for the Cosmos-originated denom so that orchestrators
+// can deploy a matching contract. The request is settled once the
+// corresponding ERC20DeployedEvent is observed.
+func (k msgServer) RequestERC20Deployment(c context.Context, msg *types.MsgRequestERC20Deployment) (*types.MsgRequestERC20DeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	// vouchers of the other EVM chains are Cosmos assets on this chain
+	if _, err := k.gravityDenomToERC20(ctx, msg.Denom); err == nil {
+		return nil, errors.Wrapf(types.ErrInvalid, "%s is an Ethereum-originated denom", msg.Denom)
+	}
+
+	if err := k.checkBridgeNotMigrating(ctx); err != nil {
+		return nil, err
+	}
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, exists := k.getERC20DeploymentRequest(ctx, msg.Denom); exists {
+		return nil, errors.Wrapf(types.ErrInvalid, "ERC20 deployment already requested for denom %s", msg.Denom)
+	}
+
+	// deployments can only be requested for denoms with registered metadata,
+	// which bounds the number of pending requests
+	if !k.hasERC20Metadata(ctx, msg.Denom) {
+		return nil, errors.Wrapf(
+			types.ErrInvalid,
+			"no metadata registered for denom %s; register it or deploy the ERC20 through the Gravity contract directly", msg.Denom,
+		)
+	}
+
+	req, err := k.expectedERC20DeploymentParams(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	req.Requester = msg.Signer
+	req.Height = uint64(ctx.BlockHeight())
+	k.setERC20DeploymentRequest(ctx, req)
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeERC20DeploymentRequested,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCosmosDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Name, req.Erc20Name),
+			sdk.NewAttribute(types.AttributeKeyERC20Symbol, req.Erc20Symbol),
+			sdk.NewAttribute(types.AttributeKeyERC20Decimals, fmt.Sprint(req.Erc20Decimals)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+
+	return &types.MsgRequestERC20DeploymentResponse{}, nil
+}
+
+// MigrateBridgeContract handles MsgMigrateBridgeContract. It records the
+// migration and freezes new outbound traffic: sends to Ethereum, batches,
+// contract calls and ERC20 deployment requests. The migration is completed in
+// the EndBlocker once every in-flight batch and contract call has completed or
+// timed out.
+func (k msgServer) MigrateBridgeContract(c context.Context, msg *types.MsgMigrateBridgeContract) (*types.MsgMigrateBridgeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.checkBridgeNotMigrating(ctx); err != nil {
+		return nil, err
+	}
+
+	oldBridgeAddress := k.getBridgeContractAddress(ctx)
+	if common.HexToAddress(oldBridgeAddress) == common.HexToAddress(msg.NewBridgeEthereumAddress) {
+		return nil, errors.Wrapf(types.ErrInvalid, "%s is already the bridge contract", msg.NewBridgeEthereumAddress)
+	}
+
+	migration := types.BridgeMigration{
+		NewBridgeEthereumAddress: common.HexToAddress(msg.NewBridgeEthereumAddress).Hex(),
+		BridgeDeploymentHeight:   msg.BridgeDeploymentHeight,
+		Height:                   uint64(ctx.BlockHeight()),
+	}
+	k.setBridgeMigration(ctx, migration)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeMigrationStarted{
+		OldBridgeEthereumAddress: oldBridgeAddress,
+		NewBridgeEthereumAddress: migration.NewBridgeEthereumAddress,
+		BridgeDeploymentHeight:   migration.BridgeDeploymentHeight,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgMigrateBridgeContractResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer
+func (k msgServer) UpdateParams(c context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	// the bridge chain id identifies the EVM chain
+	if evmChainID := k.EVMChainID(ctx); msg.Params.BridgeChainId != evmChainID {
+		return nil, errors.Wrapf(types.ErrInvalid, "bridge chain id of evm chain %d cannot be changed", evmChainID)
+	}
+	// the signatures in flight are bound to the bridge contract and gravity id, which only a migration can change
+	params := k.GetParams(ctx)
+	if msg.Params.BridgeEthereumAddress != params.BridgeEthereumAddress {
+		return nil, errors.Wrap(types.ErrInvalid, "bridge ethereum address cannot be changed, use MsgMigrateBridgeContract")
+	}
+	if msg.Params.GravityId != params.GravityId {
+		return nil, errors.Wrap(types.ErrInvalid, "gravity id cannot be changed, use MsgMigrateBridgeContract")
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddEVMChain handles MsgAddEVMChain. The new chain starts without state, its
+// first signer set tx is created in the next block.
+func (k msgServer) AddEVMChain(c context.Context, msg *types.MsgAddEVMChain) (*types.MsgAddEVMChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.addEVMChain(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(msg.Params.BridgeChainId)),
+		),
+	)
+
+	return &types.MsgAddEVMChainResponse{}, nil
+}
+
+// RegisterIBCForward handles MsgRegisterIBCForward. Anyone can register a
+// forward: its account is derived from the forward itself, so registering it
+// again is a no-op and it can never be changed. The coins of deposits to the
+// account made before it is registered cannot be recovered.
+func (k msgServer) RegisterIBCForward(c context.Context, msg *types.MsgRegisterIBCForward) (*types.MsgRegisterIBCForwardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := msg.Forward.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	account := k.setIBCForward(ctx, msg.Forward)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyIBCForwardAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyIBCForwardReceiver, msg.Forward.Receiver),
+			sdk.NewAttribute(types.AttributeKeyIBCForwardChannel, msg.Forward.Channel),
+		),
+	)
+
+	return &types.MsgRegisterIBCForwardResponse{Account: account.String()}, nil
+}
+
+// SubmitERC20MetadataVote handles MsgERC20MetadataVote. The vote replaces the
+// previous vote of the signer's validator for the token, and the metadata is
+// registered once validators with enough power agree on it. Votes for tokens
+// whose vouchers already have metadata are ignored, so that orchestrators
+// racing the registration don't fail.
+func (k msgServer) SubmitERC20MetadataVote(c context.Context, msg *types.MsgERC20MetadataVote) (*types.MsgERC20MetadataVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	k, err := k.forEVMChain(ctx, msg.EvmChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := k.getSignerValidator(ctx, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(msg.TokenContract)
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); isCosmosOriginated {
+		return nil, errors.Wrapf(types.ErrInvalid, "%s is a Cosmos-originated token", contract.Hex())
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, k.gravityDenom(ctx, contract)); found {
+		k.deleteERC20MetadataVotes(ctx, contract)
+		return &types.MsgERC20MetadataVoteResponse{}, nil
+	}
+
+	k.setERC20MetadataVote(ctx, contract, val, msg.Metadata)
+	k.tallyERC20MetadataVotes(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyValidatorAddr, val.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Contract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyERC20Symbol, msg.Metadata.Symbol),
+		),
+	)
+
+	return &types.MsgERC20MetadataVoteResponse{}, nil
+}
+
 // getSignerValidator takes an sdk.AccAddress that represents either a validator or orchestrator address and returns
 // the assoicated validator address
 func (k Keeper) getSignerValidator(ctx sdk.Context, signerString string) (sdk.ValAddress, error) {
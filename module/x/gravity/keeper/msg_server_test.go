@@ -8,6 +8,7 @@ import (
 
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
@@ -527,6 +528,65 @@ func TestMsgServer_SetDelegateKeys(t *testing.T) {
 	})
 }
 
+func TestMsgServer_SetDelegateKeysEIP712(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		ethAddr1    = ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		chainID     = gk.GetParams(ctx).BridgeChainId
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	msgServer := NewMsgServerImpl(gk)
+	signTypedData := func(nonce, chainID uint64) []byte {
+		hash, err := types.DelegateKeysTypedDataHash(valAddr1.String(), nonce, chainID)
+		require.NoError(t, err)
+		sig, err := ethCrypto.Sign(hash, ethPrivKey)
+		require.NoError(t, err)
+		// wallets return the recovery id as 27 or 28
+		sig[64] += 27
+		return sig
+	}
+	newMsg := func(sig []byte) *types.MsgDelegateKeys {
+		msg := types.NewMsgDelegateKeys(valAddr1, orcAddr1, ethAddr1.Hex(), sig)
+		msg.SignatureVersion = types.DelegateKeysSignatureVersionEIP712
+		return msg
+	}
+
+	t.Run("Proto signature", func(t *testing.T) {
+		signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysSignMsg{ValidatorAddress: valAddr1.String(), Nonce: 0})
+		sig, err := types.NewEthereumSignature(ethCrypto.Keccak256Hash(signMsgBz).Bytes(), ethPrivKey)
+		require.NoError(t, err)
+		_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), newMsg(sig))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+	})
+
+	t.Run("Other chain id", func(t *testing.T) {
+		_, err := msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), newMsg(signTypedData(0, chainID+1)))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+	})
+
+	t.Run("Other nonce", func(t *testing.T) {
+		_, err := msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), newMsg(signTypedData(1, chainID)))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+	})
+
+	_, err = msgServer.SetDelegateKeys(sdk.WrapSDKContext(ctx), newMsg(signTypedData(0, chainID)))
+	require.NoError(t, err)
+	require.Equal(t, ethAddr1, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+	require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+}
+
 func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	var (
 		env = CreateTestEnv(t)
@@ -563,6 +623,323 @@ func TestMsgServer_SubmitEthereumHeightVote(t *testing.T) {
 	require.Equal(t, gk.GetEthereumHeightVote(ctx, valAddr1).EthereumHeight, uint64(5))
 }
 
+func TestMsgServer_SubmitERC20MetadataVote(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr2    = sdk.ValAddress(orcAddr2)
+
+		orcAddr3, _ = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		valAddr3    = sdk.ValAddress(orcAddr3)
+
+		usdc     = common.HexToAddress(TokenContractAddrs[0])
+		stake    = common.HexToAddress(TokenContractAddrs[1])
+		spaced   = common.HexToAddress(TokenContractAddrs[2])
+		metadata = types.ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}
+	)
+
+	{ // setup for getSignerValidator
+		gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2, valAddr3)
+		gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+		gk.SetOrchestratorValidatorAddress(ctx, valAddr2, orcAddr2)
+		gk.SetOrchestratorValidatorAddress(ctx, valAddr3, orcAddr3)
+	}
+
+	msgServer := NewMsgServerImpl(gk)
+	vote := func(orc sdk.AccAddress, contract common.Address, metadata types.ERC20Metadata) {
+		_, err := msgServer.SubmitERC20MetadataVote(sdk.WrapSDKContext(ctx), types.NewMsgERC20MetadataVote(contract, metadata, orc))
+		require.NoError(t, err)
+	}
+
+	// votes for different metadata don't add up
+	vote(orcAddr1, usdc, metadata)
+	vote(orcAddr2, usdc, types.ERC20Metadata{Name: "Fake Coin", Symbol: "FAKE", Decimals: 18})
+	_, found := env.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(usdc))
+	require.False(t, found)
+	require.Len(t, gk.getERC20MetadataVotes(ctx), 2)
+
+	// the metadata is registered once two thirds of the power agree on it
+	vote(orcAddr3, usdc, metadata)
+	md, found := env.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(usdc))
+	require.True(t, found)
+	require.Equal(t, types.GravityDenom(usdc), md.Base)
+	require.Equal(t, "USDC", md.Display)
+	require.Equal(t, "USD Coin", md.Name)
+	require.Len(t, md.DenomUnits, 2)
+	require.Equal(t, uint32(6), md.DenomUnits[1].Exponent)
+	require.Empty(t, gk.getERC20MetadataVotes(ctx))
+
+	// later votes never replace it
+	vote(orcAddr1, usdc, types.ERC20Metadata{Name: "Fake Coin", Symbol: "FAKE", Decimals: 18})
+	md, _ = env.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(usdc))
+	require.Equal(t, "USD Coin", md.Name)
+	require.Empty(t, gk.getERC20MetadataVotes(ctx))
+
+	// symbols that are denoms of the chain or invalid denoms are not used as
+	// the display unit
+	for contract, symbol := range map[common.Address]string{stake: "stake", spaced: "US D"} {
+		vote(orcAddr1, contract, types.ERC20Metadata{Name: "Token", Symbol: symbol, Decimals: 6})
+		vote(orcAddr2, contract, types.ERC20Metadata{Name: "Token", Symbol: symbol, Decimals: 6})
+		md, found := env.BankKeeper.GetDenomMetaData(ctx, types.GravityDenom(contract))
+		require.True(t, found, symbol)
+		require.Equal(t, types.GravityDenom(contract), md.Display)
+		require.Len(t, md.DenomUnits, 1)
+		require.Equal(t, symbol, md.Symbol)
+	}
+
+	// votes for Cosmos-originated tokens are rejected
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ustake", common.HexToAddress(TokenContractAddrs[3]))
+	_, err := msgServer.SubmitERC20MetadataVote(sdk.WrapSDKContext(ctx), types.NewMsgERC20MetadataVote(common.HexToAddress(TokenContractAddrs[3]), metadata, orcAddr1))
+	require.Error(t, err)
+}
+
+func TestMsgServer_ResyncEventNonce(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr2    = sdk.ValAddress(orcAddr2)
+
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	{ // setup for getSignerValidator
+		gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2)
+		gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+		gk.SetOrchestratorValidatorAddress(ctx, valAddr2, orcAddr2)
+	}
+
+	msgServer := NewMsgServerImpl(gk)
+
+	// validator 2 has voted on an event above the last observed nonce
+	gk.setLastObservedEventNonce(ctx, 5)
+	gk.setLastEventNonceByValidator(ctx, valAddr1, 2)
+	gk.setLastEventNonceByValidator(ctx, valAddr2, 5)
+
+	pendingEvent := &types.SendToCosmosEvent{
+		EventNonce:     6,
+		TokenContract:  testContract.Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: testContract.Hex(),
+		CosmosReceiver: orcAddr2.String(),
+		EthereumHeight: 200,
+	}
+	_, err := gk.recordEventVote(ctx, pendingEvent, valAddr2)
+	require.NoError(t, err)
+
+	res, err := msgServer.ResyncEventNonce(sdk.WrapSDKContext(ctx), types.NewMsgResyncEventNonce(orcAddr1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.PreviousEventNonce)
+	require.Equal(t, uint64(5), res.EventNonce)
+	require.Equal(t, uint64(5), gk.getLastEventNonceByValidator(ctx, valAddr1))
+
+	// the resynced validator can now vote on the next event
+	_, err = gk.recordEventVote(ctx, pendingEvent, valAddr1)
+	require.NoError(t, err)
+
+	record := gk.GetEthereumEventVoteRecord(ctx, pendingEvent.EventNonce, pendingEvent.Hash())
+	require.NotNil(t, record)
+	require.Equal(t, []string{valAddr2.String(), valAddr1.String()}, record.Votes)
+
+	t.Run("Validator not behind", func(t *testing.T) {
+		_, err := msgServer.ResyncEventNonce(sdk.WrapSDKContext(ctx), types.NewMsgResyncEventNonce(orcAddr1))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not behind")
+
+		// votes already cast above the last observed nonce are kept
+		require.Equal(t, uint64(6), gk.getLastEventNonceByValidator(ctx, valAddr2))
+		_, err = msgServer.ResyncEventNonce(sdk.WrapSDKContext(ctx), types.NewMsgResyncEventNonce(orcAddr2))
+		require.Error(t, err)
+		require.Equal(t, uint64(6), gk.getLastEventNonceByValidator(ctx, valAddr2))
+	})
+
+	t.Run("Non-existent signer", func(t *testing.T) {
+		_, err := msgServer.ResyncEventNonce(sdk.WrapSDKContext(ctx), types.NewMsgResyncEventNonce(nonexistentOrcAddr))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not orchestrator or validator")
+	})
+}
+
+func TestMsgServer_RequestERC20Deployment(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		requester, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		ethAddr      = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		testDenom    = "ustake"
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	env.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    testDenom,
+		Display: "stake",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "stake", Exponent: 6},
+		},
+	})
+	require.NoError(t, env.AddBalanceToBank(ctx, requester, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10000))))
+
+	msgServer := NewMsgServerImpl(gk)
+
+	_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, testDenom))
+	require.NoError(t, err)
+
+	res, err := gk.ERC20DeploymentRequests(sdk.WrapSDKContext(ctx), &types.ERC20DeploymentRequestsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ERC20DeploymentRequest{{
+		Denom:         testDenom,
+		Erc20Name:     "stake",
+		Erc20Symbol:   "stake",
+		Erc20Decimals: 6,
+		Requester:     requester.String(),
+		Height:        uint64(ctx.BlockHeight()),
+	}}, res.Requests)
+
+	t.Run("Duplicate request", func(t *testing.T) {
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, testDenom))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "already requested")
+	})
+
+	t.Run("SendToEthereum before deployment", func(t *testing.T) {
+		_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+			Sender:            requester.String(),
+			EthereumRecipient: ethAddr.Hex(),
+			Amount:            sdk.NewInt64Coin(testDenom, 1000),
+			BridgeFee:         sdk.NewInt64Coin(testDenom, 10),
+		})
+		require.ErrorIs(t, err, types.ErrERC20NotDeployed)
+		require.Contains(t, err.Error(), "requested but not yet observed")
+	})
+
+	t.Run("Ethereum-originated denom", func(t *testing.T) {
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, types.GravityDenom(testContract)))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Ethereum-originated")
+	})
+
+	t.Run("Denom without metadata or supply", func(t *testing.T) {
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, "uunknown"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no metadata registered")
+	})
+
+	t.Run("Denom with supply but without metadata", func(t *testing.T) {
+		require.NoError(t, env.AddBalanceToBank(ctx, requester, sdk.NewCoins(sdk.NewInt64Coin("unometadata", 10000))))
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, "unometadata"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no metadata registered")
+
+		_, pending := gk.getERC20DeploymentRequest(ctx, "unometadata")
+		require.False(t, pending)
+	})
+
+	t.Run("Deployment settles the request", func(t *testing.T) {
+		require.NoError(t, gk.Handle(ctx, &types.ERC20DeployedEvent{
+			EventNonce:     1,
+			CosmosDenom:    testDenom,
+			TokenContract:  testContract.Hex(),
+			Erc20Name:      "stake",
+			Erc20Symbol:    "stake",
+			Erc20Decimals:  6,
+			EthereumHeight: 100,
+		}))
+
+		_, pending := gk.getERC20DeploymentRequest(ctx, testDenom)
+		require.False(t, pending)
+
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(requester, testDenom))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "already exists")
+	})
+}
+
+func TestMsgServer_MigrateBridgeContract(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		authority, _ = sdk.AccAddressFromBech32(gk.GetAuthority())
+		sender, _    = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		ethAddr      = common.HexToAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+		newBridge    = common.HexToAddress("0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7")
+		testContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	t.Run("Invalid authority", func(t *testing.T) {
+		_, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), types.NewMsgMigrateBridgeContract(sender, newBridge.Hex(), 100))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid authority")
+		require.Nil(t, gk.GetBridgeMigration(ctx))
+	})
+
+	t.Run("Current bridge contract", func(t *testing.T) {
+		current := gk.GetParams(ctx).BridgeEthereumAddress
+		_, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), types.NewMsgMigrateBridgeContract(authority, current, 100))
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.Nil(t, gk.GetBridgeMigration(ctx))
+	})
+
+	_, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), types.NewMsgMigrateBridgeContract(authority, newBridge.Hex(), 100))
+	require.NoError(t, err)
+	require.Equal(t, &types.BridgeMigration{
+		NewBridgeEthereumAddress: newBridge.Hex(),
+		BridgeDeploymentHeight:   100,
+		Height:                   uint64(ctx.BlockHeight()),
+	}, gk.GetBridgeMigration(ctx))
+
+	var started bool
+	for _, event := range ctx.EventManager().Events() {
+		started = started || event.Type == "gravity.v1.EventBridgeMigrationStarted"
+	}
+	require.True(t, started)
+
+	t.Run("Migration already in progress", func(t *testing.T) {
+		_, err := msgServer.MigrateBridgeContract(sdk.WrapSDKContext(ctx), types.NewMsgMigrateBridgeContract(authority, ethAddr.Hex(), 100))
+		require.ErrorIs(t, err, types.ErrBridgeMigrationInProgress)
+	})
+
+	t.Run("SendToEthereum is frozen", func(t *testing.T) {
+		vouchers := sdk.NewCoins(types.NewERC20Token(1000, testContract).GravityCoin())
+		require.NoError(t, env.AddBalanceToBank(ctx, sender, vouchers))
+
+		_, err := msgServer.SendToEthereum(sdk.WrapSDKContext(ctx), &types.MsgSendToEthereum{
+			Sender:            sender.String(),
+			EthereumRecipient: ethAddr.Hex(),
+			Amount:            types.NewERC20Token(100, testContract).GravityCoin(),
+			BridgeFee:         types.NewERC20Token(1, testContract).GravityCoin(),
+		})
+		require.ErrorIs(t, err, types.ErrBridgeMigrationInProgress)
+		require.Equal(t, vouchers, env.BankKeeper.GetAllBalances(ctx, sender))
+	})
+
+	t.Run("ERC20 deployment requests are frozen", func(t *testing.T) {
+		_, err := msgServer.RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(sender, "stake"))
+		require.ErrorIs(t, err, types.ErrBridgeMigrationInProgress)
+	})
+
+	t.Run("Contract calls are frozen", func(t *testing.T) {
+		require.Nil(t, gk.CreateContractCallTx(ctx, 1, []byte{0x01}, ethAddr, []byte{}, []types.ERC20Token{}, []types.ERC20Token{}))
+	})
+}
+
 func TestEthVerify(t *testing.T) {
 	// Replace privKeyHexStr and addrHexStr with your own private key and address
 	// HEX values.
@@ -617,3 +994,411 @@ func TestEthVerify(t *testing.T) {
 	gorcSig := "0xbda7037e448ca07ac91f5f386b72df37b6bbacf102b2c8f5acb58b5e053d68d96875ce9e442433bea55ac083230f492670ca2c07a8303c332dca06b1c0758c661b"
 	require.Equal(t, hexutil.Encode(sig), gorcSig)
 }
+
+func TestMsgServer_RotateDelegateKeys(t *testing.T) {
+	oldEthPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	newEthPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context.WithBlockHeight(100)
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		oldEthAddr  = ethCrypto.PubkeyToAddress(oldEthPrivKey.PublicKey)
+		newEthAddr  = ethCrypto.PubkeyToAddress(newEthPrivKey.PublicKey)
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1)
+
+	// Set the sequence to 1 because the antehandler will do this in the full
+	// chain.
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, oldEthAddr)
+	gk.setEthereumOrchestratorAddress(ctx, oldEthAddr, orcAddr1)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	signRotation := func(orcAddr sdk.AccAddress, ethAddr common.Address, privKey *ecdsa.PrivateKey) []byte {
+		signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysRotationSignMsg{
+			ValidatorAddress:    valAddr1.String(),
+			OrchestratorAddress: orcAddr.String(),
+			EthereumAddress:     ethAddr.Hex(),
+			Nonce:               0,
+		})
+		sig, err := types.NewEthereumSignature(ethCrypto.Keccak256Hash(signMsgBz).Bytes(), privKey)
+		require.NoError(t, err)
+		return sig
+	}
+
+	t.Run("Missing current key signature", func(t *testing.T) {
+		newSig := signRotation(orcAddr2, newEthAddr, newEthPrivKey)
+		msg := types.NewMsgRotateDelegateKeys(valAddr1, orcAddr2, newEthAddr.Hex(), newSig, newSig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "current Ethereum address")
+	})
+
+	t.Run("Signatures over other keys", func(t *testing.T) {
+		oldSig := signRotation(orcAddr1, newEthAddr, oldEthPrivKey)
+		newSig := signRotation(orcAddr1, newEthAddr, newEthPrivKey)
+		msg := types.NewMsgRotateDelegateKeys(valAddr1, orcAddr2, newEthAddr.Hex(), oldSig, newSig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+	})
+
+	t.Run("Unchanged keys", func(t *testing.T) {
+		sig := signRotation(orcAddr1, oldEthAddr, oldEthPrivKey)
+		msg := types.NewMsgRotateDelegateKeys(valAddr1, orcAddr1, oldEthAddr.Hex(), sig, sig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+	})
+
+	t.Run("Validator without delegate keys", func(t *testing.T) {
+		msg := types.NewMsgRotateDelegateKeys(sdk.ValAddress(orcAddr2), orcAddr2, newEthAddr.Hex(), []byte("sig"), []byte("sig"))
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "no delegate keys")
+	})
+
+	t.Run("Rotate both keys", func(t *testing.T) {
+		oldSig := signRotation(orcAddr2, newEthAddr, oldEthPrivKey)
+		newSig := signRotation(orcAddr2, newEthAddr, newEthPrivKey)
+		msg := types.NewMsgRotateDelegateKeys(valAddr1, orcAddr2, newEthAddr.Hex(), oldSig, newSig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		require.Equal(t, newEthAddr, gk.GetValidatorEthereumAddress(ctx, valAddr1))
+		require.Equal(t, orcAddr2, gk.GetEthereumOrchestratorAddress(ctx, newEthAddr))
+		require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr2))
+		require.Empty(t, gk.GetEthereumOrchestratorAddress(ctx, oldEthAddr))
+		require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+
+		require.Equal(t, uint64(100), gk.GetDelegateKeysRotationHeight(ctx, valAddr1))
+		require.Equal(t, uint64(100), gk.GetLastEthereumKeyRotationHeight(ctx))
+		require.True(t, gk.ethereumKeyRotatedSince(ctx, 99))
+		require.False(t, gk.ethereumKeyRotatedSince(ctx, 100))
+	})
+
+	t.Run("Rotation cooldown", func(t *testing.T) {
+		sig := signRotation(orcAddr1, newEthAddr, newEthPrivKey)
+		msg := types.NewMsgRotateDelegateKeys(valAddr1, orcAddr1, newEthAddr.Hex(), sig, sig)
+		_, err := msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "next rotation allowed")
+
+		// the orchestrator alone can be rotated once the cooldown is over
+		ctx := ctx.WithBlockHeight(int64(100 + gk.GetParams(ctx).DelegateKeysRotationCooldown))
+		_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+		require.Equal(t, orcAddr1, gk.GetEthereumOrchestratorAddress(ctx, newEthAddr))
+		require.Equal(t, uint64(100), gk.GetLastEthereumKeyRotationHeight(ctx))
+	})
+}
+
+func TestMsgServer_AddOrchestrator(t *testing.T) {
+	ethPrivKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		env         = CreateTestEnv(t)
+		ctx         = env.Context.WithBlockHeight(100)
+		gk          = env.GravityKeeper
+		orcAddr1, _ = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+		orcAddr2, _ = sdk.AccAddressFromBech32("cosmos164knshrzuuurf05qxf3q5ewpfnwzl4gj4m4dfy")
+		standby, _  = sdk.AccAddressFromBech32("cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7")
+		valAddr1    = sdk.ValAddress(orcAddr1)
+		valAddr2    = sdk.ValAddress(orcAddr2)
+		ethAddr1    = ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey)
+		ethAddr2    = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	)
+
+	gk.StakingKeeper = NewStakingKeeperMock(valAddr1, valAddr2)
+
+	// Set the sequence to 1 because the antehandler will do this in the full
+	// chain.
+	acc := env.AccountKeeper.NewAccountWithAddress(ctx, orcAddr1)
+	acc.SetSequence(1)
+	env.AccountKeeper.SetAccount(ctx, acc)
+
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr1, orcAddr1)
+	gk.setValidatorEthereumAddress(ctx, valAddr1, ethAddr1)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr1, orcAddr1)
+	gk.SetOrchestratorValidatorAddress(ctx, valAddr2, orcAddr2)
+	gk.setValidatorEthereumAddress(ctx, valAddr2, ethAddr2)
+	gk.setEthereumOrchestratorAddress(ctx, ethAddr2, orcAddr2)
+
+	msgServer := NewMsgServerImpl(gk)
+
+	t.Run("Validator without delegate keys", func(t *testing.T) {
+		msg := types.NewMsgAddOrchestrator(sdk.ValAddress(standby), nonexistentOrcAddr)
+		_, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "no delegate keys")
+	})
+
+	t.Run("Add standby orchestrator", func(t *testing.T) {
+		_, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgAddOrchestrator(valAddr1, standby))
+		require.NoError(t, err)
+
+		require.Equal(t, []sdk.AccAddress{standby}, gk.GetAdditionalOrchestrators(ctx, valAddr1))
+		val, err := gk.getSignerValidator(ctx, standby.String())
+		require.NoError(t, err)
+		require.Equal(t, valAddr1, val)
+
+		res, err := gk.DelegateKeysByValidator(sdk.WrapSDKContext(ctx), &types.DelegateKeysByValidatorRequest{ValidatorAddress: valAddr1.String()})
+		require.NoError(t, err)
+		require.Equal(t, orcAddr1.String(), res.OrchestratorAddress)
+		require.Equal(t, []string{standby.String()}, res.AdditionalOrchestratorAddresses)
+	})
+
+	t.Run("Orchestrator in use", func(t *testing.T) {
+		_, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgAddOrchestrator(valAddr1, orcAddr2))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "in use")
+
+		_, err = msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgAddOrchestrator(valAddr2, standby))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "in use")
+	})
+
+	t.Run("Duplicate submissions are counted once", func(t *testing.T) {
+		sendToCosmosEvent := &types.SendToCosmosEvent{
+			EventNonce:     1,
+			TokenContract:  ethAddr2.Hex(),
+			Amount:         sdk.NewInt(1000),
+			EthereumSender: ethAddr1.Hex(),
+			CosmosReceiver: orcAddr1.String(),
+			EthereumHeight: 200,
+		}
+		event, err := types.PackEvent(sendToCosmosEvent)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{Event: event, Signer: orcAddr1.String()})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumEvent(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumEvent{Event: event, Signer: standby.String()})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "non contiguous event nonce")
+		require.Len(t, gk.GetEthereumEventVoteRecord(ctx, 1, sendToCosmosEvent.Hash()).Votes, 1)
+
+		signerSetTx := gk.CreateSignerSetTx(ctx)
+		signature, err := types.NewEthereumSignature(signerSetTx.GetCheckpoint([]byte(gk.getGravityID(ctx))), ethPrivKey)
+		require.NoError(t, err)
+		confirmation, err := types.PackConfirmation(&types.SignerSetTxConfirmation{
+			SignerSetNonce: signerSetTx.Nonce,
+			EthereumSigner: ethAddr1.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{Confirmation: confirmation, Signer: standby.String()})
+		require.NoError(t, err)
+		_, err = msgServer.SubmitEthereumTxConfirmation(sdk.WrapSDKContext(ctx), &types.MsgSubmitEthereumTxConfirmation{Confirmation: confirmation, Signer: orcAddr1.String()})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "signature duplicate")
+	})
+
+	t.Run("Too many orchestrators", func(t *testing.T) {
+		for i := 1; i < types.MaxAdditionalOrchestrators; i++ {
+			orch := sdk.AccAddress(fmt.Sprintf("orchestrator%08d", i))
+			_, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgAddOrchestrator(valAddr1, orch))
+			require.NoError(t, err)
+		}
+
+		_, err := msgServer.AddOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgAddOrchestrator(valAddr1, nonexistentOrcAddr))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "already has")
+		require.Len(t, gk.GetAdditionalOrchestrators(ctx, valAddr1), types.MaxAdditionalOrchestrators)
+	})
+
+	t.Run("Remove orchestrator", func(t *testing.T) {
+		_, err := msgServer.RemoveOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgRemoveOrchestrator(valAddr1, orcAddr1))
+		require.ErrorIs(t, err, types.ErrDelegateKeys)
+		require.Contains(t, err.Error(), "not an additional orchestrator")
+
+		orch := sdk.AccAddress(fmt.Sprintf("orchestrator%08d", 1))
+		_, err = msgServer.RemoveOrchestrator(sdk.WrapSDKContext(ctx), types.NewMsgRemoveOrchestrator(valAddr1, orch))
+		require.NoError(t, err)
+		require.Len(t, gk.GetAdditionalOrchestrators(ctx, valAddr1), types.MaxAdditionalOrchestrators-1)
+		_, err = gk.getSignerValidator(ctx, orch.String())
+		require.Error(t, err)
+	})
+
+	t.Run("Rotate to an additional orchestrator", func(t *testing.T) {
+		signMsgBz := env.Marshaler.MustMarshal(&types.DelegateKeysRotationSignMsg{
+			ValidatorAddress:    valAddr1.String(),
+			OrchestratorAddress: standby.String(),
+			EthereumAddress:     ethAddr1.Hex(),
+			Nonce:               0,
+		})
+		sig, err := types.NewEthereumSignature(ethCrypto.Keccak256Hash(signMsgBz).Bytes(), ethPrivKey)
+		require.NoError(t, err)
+
+		_, err = msgServer.RotateDelegateKeys(sdk.WrapSDKContext(ctx), types.NewMsgRotateDelegateKeys(valAddr1, standby, ethAddr1.Hex(), sig, sig))
+		require.NoError(t, err)
+
+		require.Equal(t, standby, gk.GetEthereumOrchestratorAddress(ctx, ethAddr1))
+		require.False(t, gk.hasAdditionalOrchestrator(ctx, valAddr1, standby))
+		require.Equal(t, valAddr1, gk.GetOrchestratorValidatorAddress(ctx, standby))
+		require.Empty(t, gk.GetOrchestratorValidatorAddress(ctx, orcAddr1))
+	})
+}
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		authority, _ = sdk.AccAddressFromBech32(gk.GetAuthority())
+		sender, _    = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+	)
+
+	msgServer := NewMsgServerImpl(gk)
+	params := gk.GetParams(ctx)
+	params.SignedBatchesWindow = 20_000
+	params.SlashFractionBatch = sdk.NewDecWithPrec(5, 2)
+
+	t.Run("Invalid authority", func(t *testing.T) {
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(sender, params))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid authority")
+		require.NotEqual(t, params, gk.GetParams(ctx))
+	})
+
+	t.Run("Invalid params", func(t *testing.T) {
+		invalid := params
+		invalid.SlashFractionBatch = sdk.NewDec(2)
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, invalid))
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.NotEqual(t, invalid, gk.GetParams(ctx))
+	})
+
+	t.Run("Bridge ethereum address cannot change", func(t *testing.T) {
+		changed := params
+		changed.BridgeEthereumAddress = "0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7"
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, changed))
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.Contains(t, err.Error(), "MsgMigrateBridgeContract")
+		require.NotEqual(t, changed, gk.GetParams(ctx))
+	})
+
+	t.Run("Gravity id cannot change", func(t *testing.T) {
+		changed := params
+		changed.GravityId = "othergravityid"
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, changed))
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.Contains(t, err.Error(), "MsgMigrateBridgeContract")
+		require.NotEqual(t, changed, gk.GetParams(ctx))
+	})
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, gk.GetParams(ctx))
+	require.Equal(t, params.BridgeEthereumAddress, gk.getBridgeContractAddress(ctx))
+}
+
+func TestMsgServer_AddEVMChain(t *testing.T) {
+	var (
+		env = CreateTestEnv(t)
+		ctx = env.Context
+		gk  = env.GravityKeeper
+
+		authority, _ = sdk.AccAddressFromBech32(gk.GetAuthority())
+		sender, _    = sdk.AccAddressFromBech32("cosmos1dg55rtevlfxh46w88yjpdd08sqhh5cc3xhkcej")
+	)
+
+	require.NoError(t, NewMigrator(gk).MigrateEVMChains(ctx))
+
+	msgServer := NewMsgServerImpl(gk)
+	defaultParams := gk.GetParams(ctx)
+	params := defaultParams
+	params.BridgeChainId = 137
+	params.GravityId = "polygon"
+	params.BridgeEthereumAddress = "0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7"
+
+	t.Run("Invalid authority", func(t *testing.T) {
+		_, err := msgServer.AddEVMChain(sdk.WrapSDKContext(ctx), types.NewMsgAddEVMChain(sender, params))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid authority")
+		require.False(t, gk.HasEVMChain(ctx, params.BridgeChainId))
+	})
+
+	t.Run("Chain already registered", func(t *testing.T) {
+		_, err := msgServer.AddEVMChain(sdk.WrapSDKContext(ctx), types.NewMsgAddEVMChain(authority, defaultParams))
+		require.ErrorIs(t, err, types.ErrInvalid)
+	})
+
+	t.Run("Gravity id in use", func(t *testing.T) {
+		reused := params
+		reused.GravityId = defaultParams.GravityId
+		_, err := msgServer.AddEVMChain(sdk.WrapSDKContext(ctx), types.NewMsgAddEVMChain(authority, reused))
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.False(t, gk.HasEVMChain(ctx, params.BridgeChainId))
+	})
+
+	_, err := msgServer.AddEVMChain(sdk.WrapSDKContext(ctx), types.NewMsgAddEVMChain(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{defaultParams.BridgeChainId, params.BridgeChainId}, gk.GetEVMChainIDs(ctx))
+
+	polygon := gk.ForEVMChain(params.BridgeChainId)
+	require.Equal(t, params, polygon.GetParams(ctx))
+	require.Equal(t, defaultParams, gk.GetParams(ctx))
+
+	res, err := gk.EVMChains(sdk.WrapSDKContext(ctx), &types.EVMChainsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, defaultParams.BridgeChainId, res.DefaultEvmChainId)
+	require.Equal(t, []uint64{defaultParams.BridgeChainId, params.BridgeChainId}, res.EvmChainIds)
+
+	t.Run("State is scoped to the chain", func(t *testing.T) {
+		signerSet := types.NewSignerSetTx(1, 10, types.EthereumSigners{{Power: 100, EthereumAddress: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"}})
+		polygon.SetOutgoingTx(ctx, signerSet)
+		require.Equal(t, signerSet, polygon.GetOutgoingTx(ctx, signerSet.GetStoreIndex()))
+		require.Nil(t, gk.GetOutgoingTx(ctx, signerSet.GetStoreIndex()))
+
+		paramsRes, err := gk.Params(sdk.WrapSDKContext(ctx), &types.ParamsRequest{EvmChainId: params.BridgeChainId})
+		require.NoError(t, err)
+		require.Equal(t, params, paramsRes.Params)
+
+		_, err = gk.Params(sdk.WrapSDKContext(ctx), &types.ParamsRequest{EvmChainId: 5})
+		require.ErrorIs(t, err, types.ErrUnknownEVMChain)
+	})
+
+	t.Run("Vouchers are scoped to the chain", func(t *testing.T) {
+		contract := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom := types.EVMChainGravityDenom(params.BridgeChainId, contract)
+
+		isCosmosOriginated, erc20, err := polygon.DenomToERC20Lookup(ctx, denom)
+		require.NoError(t, err)
+		require.False(t, isCosmosOriginated)
+		require.Equal(t, contract, erc20)
+
+		_, _, err = gk.DenomToERC20Lookup(ctx, denom)
+		require.Error(t, err)
+	})
+
+	t.Run("Bridge chain id cannot change", func(t *testing.T) {
+		changed := params
+		changed.BridgeChainId = 138
+		msg := types.NewMsgUpdateParams(authority, changed)
+		msg.EvmChainId = params.BridgeChainId
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrInvalid)
+		require.Equal(t, params, polygon.GetParams(ctx))
+	})
+
+	t.Run("Unknown chain", func(t *testing.T) {
+		msg := types.NewMsgUpdateParams(authority, params)
+		msg.EvmChainId = 5
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrUnknownEVMChain)
+	})
+}
@@ -14,11 +14,30 @@ import (
 )
 
 // createSendToEthereum
+// - checks that no bridge contract migration is in progress and that the bridge is not paused
 // - checks a counterpart denominator exists for the given voucher type
 // - burns the voucher for transfer amount and fees
 // - persists an OutgoingTx
 // - adds the TX to the `available` TX pool via a second index
 func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin) (uint64, error) {
+	return k.createSendToEthereumWithRefund(ctx, sender, counterpartReceiver, amount, fee, nil)
+}
+
+// createSendToEthereumWithRefund creates a SendToEthereum like
+// createSendToEthereum. If the refund forward is set, the send is refunded over
+// IBC to the forward's receiver rather than to the sender.
+func (k Keeper) createSendToEthereumWithRefund(ctx sdk.Context, sender sdk.AccAddress, counterpartReceiver string, amount sdk.Coin, fee sdk.Coin, refund *types.IBCForward) (uint64, error) {
+	if err := k.checkBridgeNotMigrating(ctx); err != nil {
+		return 0, err
+	}
+	if err := k.checkBridgeNotPaused(ctx); err != nil {
+		return 0, err
+	}
+
+	if err := k.BeforeSendToEthereum(ctx, sender, counterpartReceiver, amount, fee); err != nil {
+		return 0, err
+	}
+
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
@@ -55,17 +74,54 @@ func (k Keeper) createSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, cou
 	// rather than the denom that is the input to this function.
 
 	// set the unbatched transaction in the pool index
-	k.setUnbatchedSendToEthereum(ctx, &types.SendToEthereum{
+	send := types.SendToEthereum{
 		Id:                nextID,
 		Sender:            sender.String(),
 		EthereumRecipient: counterpartReceiver,
 		Erc20Token:        types.NewSDKIntERC20Token(amount.Amount, tokenContract),
 		Erc20Fee:          types.NewSDKIntERC20Token(fee.Amount, tokenContract),
-	})
+	}
+	if refund != nil {
+		send.IbcChannel = refund.Channel
+		send.IbcSender = refund.Receiver
+	}
+	k.setUnbatchedSendToEthereum(ctx, &send)
+
+	if err := k.AfterSendToEthereumCreated(ctx, send); err != nil {
+		return 0, err
+	}
 
 	return nextID, nil
 }
 
+// SendToEthereumFromIBC creates a SendToEthereum on behalf of the gravity IBC
+// middleware from the intermediate account that received an ICS-20 transfer.
+// Such sends cannot be cancelled as the intermediate account has no signer.
+// They are refunded over IBC through the refund forward instead, which points
+// back at the transfer's sender over the channel it was received on.
+func (k Keeper) SendToEthereumFromIBC(ctx sdk.Context, sender sdk.AccAddress, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin, refund types.IBCForward) (uint64, error) {
+	types.NormalizeCoinDenom(&amount)
+	types.NormalizeCoinDenom(&fee)
+
+	txID, err := k.createSendToEthereumWithRefund(ctx, sender, ethereumRecipient, amount, fee, &refund)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBridgeWithdrawalReceived,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.getBridgeContractAddress(ctx)),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, fmt.Sprint(k.getBridgeChainID(ctx))),
+			sdk.NewAttribute(types.AttributeKeyOutgoingTXID, fmt.Sprint(txID)),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(txID)),
+		),
+	)
+
+	return txID, nil
+}
+
 // cancelSendToEthereum
 // - checks that the provided tx actually exists
 // - deletes the unbatched tx from the pool
@@ -88,6 +144,16 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		return fmt.Errorf("can't cancel a message you didn't send")
 	}
 
+	return k.refundSendToEthereum(ctx, send)
+}
+
+// refundSendToEthereum deletes an unbatched tx from the pool and issues the
+// tokens back to its sender. Sends created by the gravity IBC middleware are
+// refunded over IBC to the sender of the ICS-20 transfer instead, as their
+// intermediate sender account has no signer.
+func (k Keeper) refundSendToEthereum(ctx sdk.Context, send *types.SendToEthereum) error {
+	sender, _ := sdk.AccAddressFromBech32(send.Sender)
+
 	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, common.HexToAddress(send.Erc20Token.Contract))
 	amountToRefund := send.Erc20Token.Amount.Add(send.Erc20Fee.Amount)
 	coinsToRefund := sdk.NewCoins(sdk.NewCoin(denom, amountToRefund))
@@ -99,24 +165,33 @@ func (k Keeper) cancelSendToEthereum(ctx sdk.Context, id uint64, s string) error
 		}
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
+	if send.IbcChannel != "" {
+		refund := types.IBCForward{Receiver: send.IbcSender, Channel: send.IbcChannel}
+		if err := k.forwardFromModule(ctx, refund, coinsToRefund[0]); err != nil {
+			return errors.Wrap(err, "refunding coins over ibc")
+		}
+	} else if senderModule, ok := k.SenderModuleAccounts[send.Sender]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, senderModule, coinsToRefund); err != nil {
+			return errors.Wrap(err, "sending coins from module account")
+		}
+	} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coinsToRefund); err != nil {
 		return errors.Wrap(err, "sending coins from module account")
 	}
 
 	k.deleteUnbatchedSendToEthereum(ctx, send.Id, send.Erc20Fee)
-	return nil
+	return k.AfterSendToEthereumCancelled(ctx, *send)
 }
 
 func (k Keeper) setUnbatchedSendToEthereum(ctx sdk.Context, ste *types.SendToEthereum) {
-	ctx.KVStore(k.storeKey).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
+	k.chainStore(ctx).Set(types.MakeSendToEthereumKey(ste.Id, ste.Erc20Fee), k.cdc.MustMarshal(ste))
 }
 
 func (k Keeper) deleteUnbatchedSendToEthereum(ctx sdk.Context, id uint64, fee types.ERC20Token) {
-	ctx.KVStore(k.storeKey).Delete(types.MakeSendToEthereumKey(id, fee))
+	k.chainStore(ctx).Delete(types.MakeSendToEthereumKey(id, fee))
 }
 
 func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contract common.Address, cb func(*types.SendToEthereum) bool) {
-	iter := prefix.NewStore(ctx.KVStore(k.storeKey), append([]byte{types.SendToEthereumKey}, contract.Bytes()...)).ReverseIterator(nil, nil)
+	iter := prefix.NewStore(k.chainStore(ctx), append([]byte{types.SendToEthereumKey}, contract.Bytes()...)).ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ste types.SendToEthereum
@@ -128,7 +203,7 @@ func (k Keeper) iterateUnbatchedSendToEthereumsByContract(ctx sdk.Context, contr
 }
 
 func (k Keeper) IterateUnbatchedSendToEthereums(ctx sdk.Context, cb func(*types.SendToEthereum) bool) {
-	iter := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SendToEthereumKey}).ReverseIterator(nil, nil)
+	iter := prefix.NewStore(k.chainStore(ctx), []byte{types.SendToEthereumKey}).ReverseIterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var ste types.SendToEthereum
@@ -149,14 +224,20 @@ func (k Keeper) getUnbatchedSendToEthereums(ctx sdk.Context) []*types.SendToEthe
 }
 
 func (k Keeper) incrementLastSendToEthereumIDKey(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LastSendToEthereumIDKey})
-	var id uint64 = 0
-	if bz != nil {
-		id = binary.BigEndian.Uint64(bz)
-	}
-	newId := id + 1
-	bz = sdk.Uint64ToBigEndian(newId)
-	store.Set([]byte{types.LastSendToEthereumIDKey}, bz)
+	newId := k.getLastSendToEthereumID(ctx) + 1
+	k.setLastSendToEthereumID(ctx, newId)
 	return newId
 }
+
+// getLastSendToEthereumID returns the id of the last send to ethereum created
+func (k Keeper) getLastSendToEthereumID(ctx sdk.Context) uint64 {
+	if bz := k.chainStore(ctx).Get([]byte{types.LastSendToEthereumIDKey}); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	return 0
+}
+
+// setLastSendToEthereumID sets the id of the last send to ethereum created
+func (k Keeper) setLastSendToEthereumID(ctx sdk.Context, id uint64) {
+	k.chainStore(ctx).Set([]byte{types.LastSendToEthereumIDKey}, sdk.Uint64ToBigEndian(id))
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
@@ -51,3 +53,59 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.EqualValues(t, exp[3], got[3])
 	require.Len(t, got, 4)
 }
+
+func TestRefundSendToEthereumFromIBC(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	transferKeeper := &mockTransferKeeper{bankKeeper: input.BankKeeper}
+	input.GravityKeeper.SetTransferKeeper(transferKeeper)
+
+	var (
+		tokenContract = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		denom         = types.GravityDenom(tokenContract)
+		intermediate  = types.IBCSendToEthereumAccount("channel-0", "osmo")
+		receiver      = common.HexToAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	)
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", AccAddrs[1])
+	require.NoError(t, err)
+	refund := types.IBCForward{Receiver: osmoAddr, Channel: "channel-0"}
+
+	send := func() *types.SendToEthereum {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 110))
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intermediate, coins))
+		id, err := input.GravityKeeper.SendToEthereumFromIBC(ctx, intermediate, receiver.Hex(), sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 10), refund)
+		require.NoError(t, err)
+
+		for _, ste := range input.GravityKeeper.getUnbatchedSendToEthereums(ctx) {
+			if ste.Id == id {
+				return ste
+			}
+		}
+		t.Fatalf("send %d not in the pool", id)
+		return nil
+	}
+
+	// the refund is sent back to the ibc sender over the channel it came from
+	ste := send()
+	require.Equal(t, "channel-0", ste.IbcChannel)
+	require.Equal(t, osmoAddr, ste.IbcSender)
+	require.NoError(t, input.GravityKeeper.refundSendToEthereum(ctx, ste))
+	require.Len(t, transferKeeper.msgs, 1)
+	msg := transferKeeper.msgs[0]
+	require.Equal(t, "channel-0", msg.SourceChannel)
+	require.Equal(t, osmoAddr, msg.Receiver)
+	require.Equal(t, AccAddrs[1].String(), msg.Sender)
+	require.Equal(t, sdk.NewInt64Coin(denom, 110), msg.Token)
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, intermediate).IsZero())
+	require.Empty(t, input.GravityKeeper.getUnbatchedSendToEthereums(ctx))
+
+	// if the transfer fails the refund is left with the sender's local account
+	// rather than the intermediate account
+	transferKeeper.err = errors.New("channel closed")
+	require.NoError(t, input.GravityKeeper.refundSendToEthereum(ctx, send()))
+	require.Len(t, transferKeeper.msgs, 1)
+	require.Equal(t, sdk.NewInt64Coin(denom, 110), input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, intermediate).IsZero())
+}
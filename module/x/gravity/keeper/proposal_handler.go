@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
@@ -32,3 +34,43 @@ func (k Keeper) HandleCommunityPoolEthereumSpendProposal(ctx sdk.Context, p *typ
 
 	return nil
 }
+
+func (k Keeper) HandleERC20MetadataProposal(ctx sdk.Context, p *types.ERC20MetadataProposal) error {
+	contract := common.HexToAddress(p.TokenContract)
+	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); isCosmosOriginated {
+		return errors.Wrapf(types.ErrInvalidERC20MetadataProposal, "%s is a Cosmos-originated token", contract.Hex())
+	}
+
+	md := p.Metadata.BankMetadata(k.gravityDenom(ctx, contract))
+	if err := md.Validate(); err != nil {
+		return errors.Wrap(types.ErrInvalidERC20MetadataProposal, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+	k.deleteERC20MetadataVotes(ctx, contract)
+	k.Logger(ctx).Info("voucher metadata set by governance", "denom", md.Base, "symbol", md.Symbol)
+
+	return nil
+}
+
+func (k Keeper) HandleIBCDenomMetadataProposal(ctx sdk.Context, p *types.IBCDenomMetadataProposal) error {
+	// the metadata of a deployed ERC20 cannot change, so it is too late
+	// to register it once the deployment has been observed
+	if erc20, exists := k.getCosmosOriginatedERC20(ctx, p.Denom); exists {
+		return errors.Wrapf(types.ErrInvalidIBCDenomMetadataProposal, "ERC20 %s already deployed for %s", erc20.Hex(), p.Denom)
+	}
+
+	k.setIBCDenomMetadata(ctx, p.Denom, p.Metadata)
+
+	// a pending deployment request must advertise the new parameters
+	if req, found := k.getERC20DeploymentRequest(ctx, p.Denom); found {
+		req.Erc20Name = p.Metadata.Name
+		req.Erc20Symbol = p.Metadata.Symbol
+		req.Erc20Decimals = p.Metadata.Decimals
+		k.setERC20DeploymentRequest(ctx, req)
+	}
+
+	k.Logger(ctx).Info("IBC denom ERC20 metadata set by governance", "denom", p.Denom, "symbol", p.Metadata.Symbol)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestHandleERC20MetadataProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	contract := common.HexToAddress(TokenContractAddrs[0])
+	denom := types.GravityDenom(contract)
+	input.BankKeeper.SetDenomMetaData(ctx, types.ERC20Metadata{Name: "Fake Coin", Symbol: "FAKE", Decimals: 18}.BankMetadata(denom))
+
+	gk.setERC20MetadataVote(ctx, contract, ValAddrs[0], types.ERC20Metadata{Name: "Fake Coin", Symbol: "FAKE", Decimals: 18})
+
+	// governance overrides metadata registered from votes, and the pending
+	// votes are dropped
+	proposal := types.NewERC20MetadataProposal("title", "description", contract.Hex(), types.ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6})
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleERC20MetadataProposal(ctx, proposal))
+	md, found := input.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, "USDC", md.Symbol)
+	require.Equal(t, uint32(6), md.DenomUnits[1].Exponent)
+	require.Empty(t, gk.getERC20MetadataVotes(ctx))
+
+	// Cosmos-originated tokens keep their own metadata
+	cosmosContract := common.HexToAddress(TokenContractAddrs[1])
+	gk.setCosmosOriginatedDenomToERC20(ctx, "ustake", cosmosContract)
+	proposal.TokenContract = cosmosContract.Hex()
+	require.Error(t, gk.HandleERC20MetadataProposal(ctx, proposal))
+
+	// invalid metadata is rejected up front
+	proposal.Metadata.Decimals = 256
+	require.Error(t, proposal.ValidateBasic())
+}
+
+func TestHandleIBCDenomMetadataProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	otherTrace := ibctransfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}
+	ibcDenom := trace.IBCDenom()
+	gk.SetTransferKeeper(&mockTransferKeeper{bankKeeper: input.BankKeeper, traces: []ibctransfertypes.DenomTrace{trace, otherTrace}})
+	require.NoError(t, input.AddBalanceToBank(ctx, AccAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000), sdk.NewInt64Coin(otherTrace.IBCDenom(), 1000))))
+
+	// without metadata the ERC20 is named after the path of the trace
+	params, err := gk.expectedERC20DeploymentParams(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, "transfer/channel-0/uatom", params.Erc20Name)
+
+	// so the same base denom received over another channel gets another name
+	otherParams, err := gk.expectedERC20DeploymentParams(ctx, otherTrace.IBCDenom())
+	require.NoError(t, err)
+	require.Equal(t, "transfer/channel-1/uatom", otherParams.Erc20Name)
+	require.Equal(t, "", params.Erc20Symbol)
+	require.Equal(t, uint64(0), params.Erc20Decimals)
+
+	// but deployments can only be requested once metadata is registered
+	_, err = NewMsgServerImpl(gk).RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(AccAddrs[0], ibcDenom))
+	require.Error(t, err)
+
+	proposal := types.NewIBCDenomMetadataProposal("title", "description", ibcDenom, types.ERC20Metadata{Name: "Atom", Symbol: "ATOM", Decimals: 6})
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, gk.HandleIBCDenomMetadataProposal(ctx, proposal))
+	_, err = NewMsgServerImpl(gk).RequestERC20Deployment(sdk.WrapSDKContext(ctx), types.NewMsgRequestERC20Deployment(AccAddrs[0], ibcDenom))
+	require.NoError(t, err)
+
+	// registered metadata overrides the trace and updates the pending request
+	proposal.Metadata = types.ERC20Metadata{Name: "Cosmos Hub Atom", Symbol: "ATOM", Decimals: 6}
+	require.NoError(t, gk.HandleIBCDenomMetadataProposal(ctx, proposal))
+	req, found := gk.getERC20DeploymentRequest(ctx, ibcDenom)
+	require.True(t, found)
+	require.Equal(t, "Cosmos Hub Atom", req.Erc20Name)
+	require.Equal(t, "ATOM", req.Erc20Symbol)
+	require.Equal(t, uint64(6), req.Erc20Decimals)
+
+	event := &types.ERC20DeployedEvent{
+		EventNonce:     1,
+		CosmosDenom:    ibcDenom,
+		TokenContract:  TokenContractAddrs[0],
+		Erc20Name:      "uatom",
+		EthereumHeight: 100,
+	}
+	require.Error(t, gk.verifyERC20DeployedEvent(ctx, event))
+
+	event.Erc20Name, event.Erc20Symbol, event.Erc20Decimals = "Cosmos Hub Atom", "ATOM", 6
+	require.NoError(t, gk.Handle(ctx, event))
+
+	// the metadata cannot change once the ERC20 is deployed
+	require.Error(t, gk.HandleIBCDenomMetadataProposal(ctx, proposal))
+
+	// only IBC denoms can be registered
+	proposal.Denom = "ustake"
+	require.Error(t, proposal.ValidateBasic())
+}
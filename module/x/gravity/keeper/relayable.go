@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// GetRelayableOutgoingTxs returns the outgoing txs the Gravity contract would
+// execute: the signer sets following the last observed one, and the batches
+// and contract calls that haven't timed out at the last observed ethereum
+// height, whose signatures by the last observed signer set hold more than the
+// contract power threshold
+func (k Keeper) GetRelayableOutgoingTxs(ctx sdk.Context) *types.RelayableOutgoingTxsResponse {
+	res := &types.RelayableOutgoingTxsResponse{}
+	signerSet := k.GetLastObservedSignerSetTx(ctx)
+	if signerSet == nil || len(signerSet.Signers) == 0 {
+		return res
+	}
+	res.SignerSetNonce = signerSet.Nonce
+
+	gravityID := []byte(k.getGravityID(ctx))
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumHeight
+	for _, prefixByte := range []byte{types.SignerSetTxPrefixByte, types.BatchTxPrefixByte, types.ContractCallTxPrefixByte} {
+		k.IterateOutgoingTxsByType(ctx, prefixByte, func(_ []byte, otx types.OutgoingTx) bool {
+			switch otx := otx.(type) {
+			case *types.SignerSetTx:
+				if otx.Nonce <= signerSet.Nonce {
+					return false
+				}
+			case *types.BatchTx:
+				if otx.Timeout <= ethereumHeight {
+					return false
+				}
+			case *types.ContractCallTx:
+				if otx.Timeout <= ethereumHeight {
+					return false
+				}
+			}
+
+			if tx, ok := k.relayableOutgoingTx(ctx, *signerSet, gravityID, otx); ok {
+				res.Txs = append(res.Txs, tx)
+			}
+			return false
+		})
+	}
+
+	return res
+}
+
+// relayableOutgoingTx orders the signatures of the outgoing tx like the
+// members of the signer set, and reports whether they hold more than the
+// contract power threshold. The signatures are matched to the members by the
+// ethereum key they were made with, since the ethereum key of a validator may
+// have changed after it signed.
+func (k Keeper) relayableOutgoingTx(ctx sdk.Context, signerSet types.SignerSetTx, gravityID []byte, otx types.OutgoingTx) (types.RelayableOutgoingTx, bool) {
+	checkpoint := otx.GetCheckpoint(gravityID)
+	signatures := make(map[common.Address][]byte)
+	k.iterateEthereumSignatures(ctx, otx.GetStoreIndex(), func(_ sdk.ValAddress, sig types.EthereumTxSignature) bool {
+		signatures[common.HexToAddress(sig.EthereumSigner)] = sig.Signature
+		return false
+	})
+
+	tx := types.RelayableOutgoingTx{
+		Checkpoint: checkpoint,
+		Signatures: make([]types.SignerSetSignature, len(signerSet.Signers)),
+	}
+	for i, signer := range signerSet.Signers {
+		tx.Signatures[i] = types.SignerSetSignature{EthereumSigner: signer.EthereumAddress, Power: signer.Power}
+
+		address := common.HexToAddress(signer.EthereumAddress)
+		sig, ok := signatures[address]
+		if !ok || types.ValidateEthereumSignature(checkpoint, sig, address) != nil {
+			continue
+		}
+		tx.Signatures[i].Signature = sig
+		tx.SignedPower += signer.Power
+	}
+	if tx.SignedPower <= types.ContractPowerThreshold {
+		return tx, false
+	}
+
+	otxAny, err := types.PackOutgoingTx(otx)
+	if err != nil {
+		panic(err)
+	}
+	tx.OutgoingTx = otxAny
+	return tx, true
+}
@@ -2,15 +2,17 @@ package keeper
 
 import (
 	"sort"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
 )
 
-// TODO here we should check the contents of the validator set against
-// the store, if they differ we should take some action to indicate to the
-// user that bridge highjacking has occurred
+// SignerSetExecuted records the signer set tx with the given nonce as the last
+// observed one. Its members must already have been checked against the
+// executed signer set, see checkSignerSetHijack.
 func (k Keeper) SignerSetExecuted(ctx sdk.Context, nonce uint64) {
 	otx := k.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(nonce))
 	if otx == nil {
@@ -29,6 +31,7 @@ func (k Keeper) SignerSetExecuted(ctx sdk.Context, nonce uint64) {
 	// We don't use CompleteOutgoingTx here so that BeginBlocker can handle
 	// pruning of old signer set txs
 	k.SetCompletedOutgoingTx(ctx, sstx)
+	k.AfterOutgoingTxCompleted(ctx, sstx)
 }
 
 func (k Keeper) GetUnsignedSignerSetTxs(ctx sdk.Context, val sdk.ValAddress) []*types.SignerSetTx {
@@ -67,3 +70,93 @@ func orderSignerSetsByNonceAscending(txs []*types.SignerSetTx) []*types.SignerSe
 
 	return txs
 }
+
+// GetSignerSetDrift compares the current validator set with the latest signer set
+// tx and reports whether a new signer set tx is due at blockHeight. One is due
+// when there is no signer set tx yet, when a validator holding more than the
+// unbonding power fraction started unbonding at blockHeight, when the power
+// diff exceeds the power diff threshold or when the latest signer set tx has
+// reached the max age.
+func (k Keeper) GetSignerSetDrift(ctx sdk.Context, blockHeight uint64) *types.SignerSetDriftResponse {
+	params := k.GetParams(ctx)
+	drift := &types.SignerSetDriftResponse{
+		PowerDiff:          sdk.ZeroDec().String(),
+		PowerDiffThreshold: params.SignerSetPowerDiffThreshold.String(),
+	}
+
+	latest := k.GetLatestSignerSetTx(ctx)
+	if latest == nil {
+		drift.WillCreateSignerSet = true
+		drift.Reason = types.SignerSetReasonNoSignerSet
+		return drift
+	}
+
+	drift.LatestSignerSetNonce = latest.Nonce
+	drift.LatestSignerSetHeight = latest.Height
+	if params.SignerSetMaxAge > 0 {
+		drift.MaxAgeHeight = latest.Height + params.SignerSetMaxAge
+	}
+
+	current := k.CurrentSignerSet(ctx)
+	powerDiff := current.PowerDiff(latest.Signers)
+	drift.PowerDiff = sdk.MustNewDecFromStr(strconv.FormatFloat(powerDiff, 'f', sdk.Precision, 64)).String()
+	drift.Changes = k.signerPowerChanges(ctx, latest.Signers, current)
+
+	switch {
+	case k.GetLastUnbondingBlockHeight(ctx) == blockHeight:
+		drift.Reason = types.SignerSetReasonUnbonding
+	case k.ethereumKeyRotatedSince(ctx, latest.Height):
+		drift.Reason = types.SignerSetReasonKeyRotation
+	case powerDiff > params.SignerSetPowerDiffThreshold.MustFloat64():
+		drift.Reason = types.SignerSetReasonPowerDiff
+	case drift.MaxAgeHeight > 0 && blockHeight >= drift.MaxAgeHeight:
+		drift.Reason = types.SignerSetReasonMaxAge
+	}
+	drift.WillCreateSignerSet = drift.Reason != ""
+
+	return drift
+}
+
+// ethereumKeyRotatedSince returns true if a validator rotated its ethereum key
+// after the given height. A signer set tx made at the height of a rotation
+// already holds the new key, as it is made at the end of the block.
+func (k Keeper) ethereumKeyRotatedSince(ctx sdk.Context, height uint64) bool {
+	rotationHeight := k.GetLastEthereumKeyRotationHeight(ctx)
+	return rotationHeight > 0 && rotationHeight > height
+}
+
+// signerPowerChanges returns the signers whose power differs between the
+// latest and the current signer set, sorted by ethereum address
+func (k Keeper) signerPowerChanges(ctx sdk.Context, latest, current types.EthereumSigners) []*types.SignerPowerChange {
+	changes := map[string]*types.SignerPowerChange{}
+	for _, signer := range latest {
+		changes[signer.EthereumAddress] = &types.SignerPowerChange{
+			EthereumAddress: signer.EthereumAddress,
+			LatestPower:     signer.Power,
+		}
+	}
+	for _, signer := range current {
+		change, ok := changes[signer.EthereumAddress]
+		if !ok {
+			change = &types.SignerPowerChange{EthereumAddress: signer.EthereumAddress}
+			changes[signer.EthereumAddress] = change
+		}
+		change.CurrentPower = signer.Power
+	}
+
+	var out []*types.SignerPowerChange
+	for _, change := range changes {
+		if change.LatestPower == change.CurrentPower {
+			continue
+		}
+		if orch := k.GetEthereumOrchestratorAddress(ctx, common.HexToAddress(change.EthereumAddress)); orch != nil {
+			if val := k.GetOrchestratorValidatorAddress(ctx, orch); val != nil {
+				change.ValidatorAddress = val.String()
+			}
+		}
+		out = append(out, change)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].EthereumAddress < out[j].EthereumAddress })
+
+	return out
+}
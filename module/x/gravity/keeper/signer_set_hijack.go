@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// checkSignerSetHijack compares the members of an executed signer set tx with
+// the signer set tx of the same nonce produced by this chain. A mismatch, or a
+// nonce this chain never produced, means the Gravity contract was updated to a
+// signer set the validators did not sign off on. The incident is recorded,
+// which pauses the bridge, and true is returned so that the signer set is not
+// marked as executed.
+//
+// The signer set with nonce 0 is set when the contract is deployed and is not
+// checked.
+func (k Keeper) checkSignerSetHijack(ctx sdk.Context, event *types.SignerSetTxExecutedEvent) bool {
+	if event.SignerSetTxNonce == 0 {
+		return false
+	}
+
+	// hashing sorts the signers, the event keeps the members in contract order
+	observedHash := fmt.Sprintf("%X", append(types.EthereumSigners{}, event.Members...).Hash())
+
+	var expectedHash string
+	if otx := k.GetOutgoingTx(ctx, types.MakeSignerSetTxKey(event.SignerSetTxNonce)); otx != nil {
+		sstx, ok := otx.(*types.SignerSetTx)
+		if !ok {
+			panic(errors.Wrapf(types.ErrInvalid, "couldn't cast to signer set for outgoing tx %s", otx))
+		}
+		expectedHash = fmt.Sprintf("%X", types.EthereumSigners(sstx.Signers).Hash())
+		if expectedHash == observedHash {
+			return false
+		}
+	} else if event.SignerSetTxNonce <= k.GetLatestSignerSetTxNonce(ctx) {
+		// the signer set tx was already pruned, there is nothing left to compare with
+		k.Logger(ctx).Error("cannot verify executed signer set tx", "signer set nonce", event.SignerSetTxNonce)
+		return false
+	}
+
+	incident := types.SignerSetHijackIncident{
+		Height:                uint64(ctx.BlockHeight()),
+		EventNonce:            event.EventNonce,
+		SignerSetTxNonce:      event.SignerSetTxNonce,
+		EthereumHeight:        event.EthereumHeight,
+		BridgeEthereumAddress: k.getBridgeContractAddress(ctx),
+		ExpectedSignersHash:   expectedHash,
+		ObservedSignersHash:   observedHash,
+		ObservedMembers:       event.Members,
+	}
+	k.setSignerSetHijackIncident(ctx, incident)
+
+	k.Logger(ctx).Error("signer set hijack detected, pausing the bridge",
+		"signer set nonce", incident.SignerSetTxNonce,
+		"event nonce", incident.EventNonce,
+		"expected signers hash", incident.ExpectedSignersHash,
+		"observed signers hash", incident.ObservedSignersHash,
+	)
+	k.emitTypedEvent(ctx, &types.EventSignerSetHijackDetected{
+		EventNonce:            incident.EventNonce,
+		SignerSetTxNonce:      incident.SignerSetTxNonce,
+		EthereumHeight:        incident.EthereumHeight,
+		BridgeEthereumAddress: incident.BridgeEthereumAddress,
+		ExpectedSignersHash:   incident.ExpectedSignersHash,
+		ObservedSignersHash:   incident.ObservedSignersHash,
+	})
+
+	return true
+}
+
+func (k Keeper) setSignerSetHijackIncident(ctx sdk.Context, incident types.SignerSetHijackIncident) {
+	key := types.MakeSignerSetHijackIncidentKey(incident.Height, incident.EventNonce)
+	k.chainStore(ctx).Set(key, k.cdc.MustMarshal(&incident))
+}
+
+// iterateSignerSetHijackIncidents iterates over the signer set hijack
+// incidents in the order they were detected
+func (k Keeper) iterateSignerSetHijackIncidents(ctx sdk.Context, cb func(types.SignerSetHijackIncident) bool) {
+	store := prefix.NewStore(k.chainStore(ctx), []byte{types.SignerSetHijackIncidentKey})
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var incident types.SignerSetHijackIncident
+		k.cdc.MustUnmarshal(iter.Value(), &incident)
+		if cb(incident) {
+			break
+		}
+	}
+}
+
+// GetSignerSetHijackIncidents returns every signer set hijack incident, resolved
+// or not
+func (k Keeper) GetSignerSetHijackIncidents(ctx sdk.Context) []*types.SignerSetHijackIncident {
+	var incidents []*types.SignerSetHijackIncident
+	k.iterateSignerSetHijackIncidents(ctx, func(incident types.SignerSetHijackIncident) bool {
+		incidents = append(incidents, &incident)
+		return false
+	})
+	return incidents
+}
+
+// IsBridgePaused returns true while a signer set hijack incident is unresolved
+func (k Keeper) IsBridgePaused(ctx sdk.Context) bool {
+	paused := false
+	k.iterateSignerSetHijackIncidents(ctx, func(incident types.SignerSetHijackIncident) bool {
+		paused = incident.ResolvedHeight == 0
+		return paused
+	})
+	return paused
+}
+
+// checkBridgeNotPaused returns ErrBridgePaused while a signer set hijack
+// incident is unresolved, as new outbound traffic would be handed to signers
+// this chain does not control
+func (k Keeper) checkBridgeNotPaused(ctx sdk.Context) error {
+	if k.IsBridgePaused(ctx) {
+		return errors.Wrap(types.ErrBridgePaused, "a bridge contract migration is required")
+	}
+	return nil
+}
+
+// resolveSignerSetHijackIncidents marks every unresolved incident as resolved
+// at the current height, which lifts the pause
+func (k Keeper) resolveSignerSetHijackIncidents(ctx sdk.Context) {
+	var unresolved []types.SignerSetHijackIncident
+	k.iterateSignerSetHijackIncidents(ctx, func(incident types.SignerSetHijackIncident) bool {
+		if incident.ResolvedHeight == 0 {
+			unresolved = append(unresolved, incident)
+		}
+		return false
+	})
+
+	for _, incident := range unresolved {
+		incident.ResolvedHeight = uint64(ctx.BlockHeight())
+		k.setSignerSetHijackIncident(ctx, incident)
+	}
+}
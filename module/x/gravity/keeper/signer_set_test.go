@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -34,6 +35,84 @@ func TestSignerSetTxExecuted(t *testing.T) {
 	assert.Equal(t, uint64(1), input.GravityKeeper.GetLatestSignerSetTxNonce(ctx))
 }
 
+func TestSignerSetHijackDetection(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	gk := input.GravityKeeper
+
+	copySigners := func(signers types.EthereumSigners) []*types.EthereumSigner {
+		var members []*types.EthereumSigner
+		for _, s := range signers {
+			members = append(members, &types.EthereumSigner{Power: s.Power, EthereumAddress: s.EthereumAddress})
+		}
+		return members
+	}
+
+	// the members of an executed signer set are reported in contract order
+	sstx := gk.CreateSignerSetTx(ctx)
+	members := copySigners(sstx.Signers)
+	members[0], members[len(members)-1] = members[len(members)-1], members[0]
+	contractOrder := copySigners(members)
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{EventNonce: 1, SignerSetTxNonce: sstx.Nonce, Members: members}))
+	require.Equal(t, contractOrder, members)
+	require.NotNil(t, gk.GetCompletedOutgoingTx(ctx, sstx.GetStoreIndex()))
+	require.Empty(t, gk.GetSignerSetHijackIncidents(ctx))
+	require.False(t, gk.IsBridgePaused(ctx))
+
+	// a signer set with different powers is a hijack
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	hijacked := gk.CreateSignerSetTx(ctx)
+	members = copySigners(hijacked.Signers)
+	members[0].Power += 1000
+	members[0], members[len(members)-1] = members[len(members)-1], members[0]
+	contractOrder = copySigners(members)
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{EventNonce: 2, SignerSetTxNonce: hijacked.Nonce, EthereumHeight: 50, Members: members}))
+	require.Nil(t, gk.GetCompletedOutgoingTx(ctx, hijacked.GetStoreIndex()))
+	require.Equal(t, sstx.Nonce, gk.GetLastObservedSignerSetTx(ctx).Nonce)
+	require.True(t, gk.IsBridgePaused(ctx))
+	require.ErrorIs(t, gk.checkBridgeNotPaused(ctx), types.ErrBridgePaused)
+	require.Nil(t, gk.CreateBatchTx(ctx, common.HexToAddress(TokenContractAddrs[0]), 10))
+
+	incidents := gk.GetSignerSetHijackIncidents(ctx)
+	require.Len(t, incidents, 1)
+	require.Equal(t, uint64(2), incidents[0].EventNonce)
+	require.Equal(t, hijacked.Nonce, incidents[0].SignerSetTxNonce)
+	require.Equal(t, uint64(50), incidents[0].EthereumHeight)
+	require.NotEmpty(t, incidents[0].ExpectedSignersHash)
+	require.NotEqual(t, incidents[0].ExpectedSignersHash, incidents[0].ObservedSignersHash)
+	require.Equal(t, contractOrder, incidents[0].ObservedMembers)
+	require.Zero(t, incidents[0].ResolvedHeight)
+
+	var detected bool
+	for _, event := range ctx.EventManager().Events() {
+		detected = detected || event.Type == "gravity.v1.EventSignerSetHijackDetected"
+	}
+	require.True(t, detected)
+
+	// a nonce this chain never produced is a hijack as well
+	require.NoError(t, gk.Handle(ctx, &types.SignerSetTxExecutedEvent{EventNonce: 3, SignerSetTxNonce: 10, Members: copySigners(sstx.Signers)}))
+	incidents = gk.GetSignerSetHijackIncidents(ctx)
+	require.Len(t, incidents, 2)
+	require.Empty(t, incidents[1].ExpectedSignersHash)
+
+	res, err := gk.SignerSetHijackIncidents(sdk.WrapSDKContext(ctx), &types.SignerSetHijackIncidentsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Incidents, 2)
+	require.True(t, res.BridgePaused)
+
+	// migrating to a new bridge contract resolves the incidents
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gk.setBridgeMigration(ctx, types.BridgeMigration{
+		NewBridgeEthereumAddress: "0x2f7E1b1B4a6d6b6Ca2a9e2A2c1F3C0c4b1e8D5A7",
+		BridgeDeploymentHeight:   100,
+		Height:                   uint64(ctx.BlockHeight()),
+	})
+	gk.CompleteBridgeMigration(ctx)
+	require.False(t, gk.IsBridgePaused(ctx))
+	for _, incident := range gk.GetSignerSetHijackIncidents(ctx) {
+		require.Equal(t, uint64(ctx.BlockHeight()), incident.ResolvedHeight)
+	}
+}
+
 func TestGetUnconfirmedSignerSetTxs(t *testing.T) {
 	input, ctx := SetupFiveValChain(t)
 	gk := input.GravityKeeper
@@ -207,6 +207,9 @@ var (
 		SlashFractionBatch:                        sdk.NewDecWithPrec(1, 2),
 		SlashFractionEthereumSignature:            sdk.NewDecWithPrec(1, 2),
 		SlashFractionConflictingEthereumSignature: sdk.NewDecWithPrec(1, 2),
+		SignerSetPowerDiffThreshold:               sdk.NewDecWithPrec(5, 2),
+		SignerSetUnbondingPowerFraction:           sdk.NewDecWithPrec(1, 2),
+		DelegateKeysRotationCooldown:              10000,
 	}
 )
 
@@ -444,6 +447,7 @@ func CreateTestEnv(t *testing.T) TestInput {
 		sdk.DefaultPowerReduction,
 		receiverModuleAccounts,
 		senderModuleAccounts,
+		authority,
 	)
 
 	stakingKeeper.SetHooks(
@@ -507,6 +511,8 @@ func MakeTestEncodingConfig() moduletestutil.TestEncodingConfig {
 				upgradeclient.LegacyProposalHandler,
 				upgradeclient.LegacyCancelProposalHandler,
 				gravityclient.ProposalHandler,
+				gravityclient.ERC20MetadataProposalHandler,
+				gravityclient.IBCDenomMetadataProposalHandler,
 			},
 		),
 		//params.AppModuleBasic{},
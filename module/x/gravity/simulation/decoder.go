@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding gravity type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		return fmt.Sprintf("%s\n%s", decodePair(cdc, kvA), decodePair(cdc, kvB))
+	}
+}
+
+// decodePair decodes the value of a pair, which is empty when the key is only
+// in the other store
+func decodePair(cdc codec.Codec, pair kv.Pair) string {
+	if len(pair.Key) == 0 {
+		return "<missing>"
+	}
+	value, err := DecodeValue(cdc, pair.Key, pair.Value)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// DecodeValue returns a readable form of the value stored under a gravity
+// store key. Protobuf values are rendered as JSON, with Any-packed outgoing
+// txs and events resolved to their concrete types.
+func DecodeValue(cdc codec.Codec, key, value []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("empty gravity store key")
+	}
+
+	switch key[0] {
+	case types.ValidatorEthereumAddressKey, types.DenomToERC20Key:
+		return common.BytesToAddress(value).Hex(), nil
+
+	case types.OrchestratorValidatorAddressKey:
+		return sdk.ValAddress(value).String(), nil
+
+	case types.EthereumOrchestratorAddressKey, types.ValidatorOrchestratorKey:
+		return sdk.AccAddress(value).String(), nil
+
+	case types.EthereumSignatureKey:
+		return decodeProto(cdc, value, &types.EthereumTxSignature{})
+
+	case types.EthereumEventVoteRecordKey:
+		return decodeProto(cdc, value, &types.EthereumEventVoteRecord{})
+
+	case types.OutgoingTxKey, types.CompletedOutgoingTxKey:
+		return decodeProto(cdc, value, &codectypes.Any{})
+
+	case types.SendToEthereumKey:
+		return decodeProto(cdc, value, &types.SendToEthereum{})
+
+	case types.LastEventNonceByValidatorKey,
+		types.LastObservedEventNonceKey,
+		types.LatestSignerSetTxNonceKey,
+		types.LastSlashedOutgoingTxBlockKey,
+		types.LastSlashedSignerSetTxNonceKey,
+		types.LastOutgoingBatchNonceKey,
+		types.LastSendToEthereumIDKey,
+		types.LastUnBondingBlockHeightKey,
+		types.DelegateKeysRotationHeightKey,
+		types.LastEthereumKeyRotationHeightKey:
+		if len(value) != 8 {
+			return "", fmt.Errorf("expected an 8 byte big endian integer, got %d bytes", len(value))
+		}
+		return strconv.FormatUint(binary.BigEndian.Uint64(value), 10), nil
+
+	case types.LastEthereumBlockHeightKey, types.EthereumHeightVoteKey:
+		return decodeProto(cdc, value, &types.LatestEthereumBlockHeight{})
+
+	case types.ERC20ToDenomKey:
+		return string(value), nil
+
+	case types.LastObservedSignerSetKey:
+		return decodeProto(cdc, value, &types.SignerSetTx{})
+
+	case types.ERC20DeploymentRequestKey:
+		return decodeProto(cdc, value, &types.ERC20DeploymentRequest{})
+
+	case types.IBCDenomMetadataKey:
+		return decodeProto(cdc, value, &types.ERC20Metadata{})
+
+	case types.BridgeMigrationKey:
+		return decodeProto(cdc, value, &types.BridgeMigration{})
+
+	case types.IBCForwardKey:
+		return decodeProto(cdc, value, &types.IBCForward{})
+
+	case types.ERC20MetadataVoteKey:
+		return decodeProto(cdc, value, &types.ERC20Metadata{})
+
+	case types.ParamsKey:
+		return decodeProto(cdc, value, &types.Params{})
+
+	case types.SignerSetHijackIncidentKey:
+		return decodeProto(cdc, value, &types.SignerSetHijackIncident{})
+
+	case types.EVMChainKey:
+		return fmt.Sprintf("%X", value), nil
+
+	case types.DefaultEVMChainIDKey:
+		if len(value) != 8 {
+			return "", fmt.Errorf("expected an 8 byte big endian integer, got %d bytes", len(value))
+		}
+		return strconv.FormatUint(binary.BigEndian.Uint64(value), 10), nil
+
+	case types.EVMChainStoreKey:
+		// the state of an EVM chain is stored under its chain id
+		if len(key) <= 9 {
+			return "", fmt.Errorf("invalid evm chain store key %X", key)
+		}
+		return DecodeValue(cdc, key[9:], value)
+
+	default:
+		return "", fmt.Errorf("invalid gravity key prefix %X", key[:1])
+	}
+}
+
+func decodeProto(cdc codec.Codec, value []byte, msg codec.ProtoMarshaler) (string, error) {
+	if err := cdc.Unmarshal(value, msg); err != nil {
+		return "", err
+	}
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
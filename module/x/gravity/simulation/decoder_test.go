@@ -0,0 +1,102 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/simulation"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+var (
+	delPk1   = ed25519.GenPrivKey().PubKey()
+	accAddr1 = sdk.AccAddress(delPk1.Address())
+	valAddr1 = sdk.ValAddress(delPk1.Address())
+	ethAddr1 = common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+)
+
+func TestDecodeStore(t *testing.T) {
+	encodingConfig := keeper.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	signerSet := types.NewSignerSetTx(1, 10, types.EthereumSigners{{Power: 100, EthereumAddress: ethAddr1.Hex()}})
+	otxBz, err := cdc.MarshalInterface(signerSet)
+	require.NoError(t, err)
+	otxJSON, err := cdc.MarshalInterfaceJSON(signerSet)
+	require.NoError(t, err)
+
+	send := types.SendToEthereum{
+		Id:                1,
+		Sender:            accAddr1.String(),
+		EthereumRecipient: ethAddr1.Hex(),
+		Erc20Token:        types.NewERC20Token(10, ethAddr1),
+		Erc20Fee:          types.NewERC20Token(1, ethAddr1),
+	}
+	height := types.LatestEthereumBlockHeight{EthereumHeight: 100, CosmosHeight: 10}
+	sendJSON := cdc.MustMarshalJSON(&send)
+	heightJSON := cdc.MustMarshalJSON(&height)
+	forward := types.IBCForward{Receiver: accAddr1.String(), Channel: "channel-0"}
+	forwardJSON := cdc.MustMarshalJSON(&forward)
+	metadata := types.ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}
+	metadataJSON := cdc.MustMarshalJSON(&metadata)
+	nonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonce, 5)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MakeValidatorEthereumAddressKey(valAddr1), Value: ethAddr1.Bytes()},
+			{Key: types.MakeOrchestratorValidatorAddressKey(accAddr1), Value: valAddr1.Bytes()},
+			{Key: types.MakeEthereumOrchestratorAddressKey(ethAddr1), Value: accAddr1.Bytes()},
+			{Key: types.MakeOutgoingTxKey(signerSet.GetStoreIndex()), Value: otxBz},
+			{Key: types.MakeSendToEthereumKey(send.Id, send.Erc20Fee), Value: cdc.MustMarshal(&send)},
+			{Key: types.MakeLastEventNonceByValidatorKey(valAddr1), Value: nonce},
+			{Key: types.MakeEthereumHeightVoteKey(valAddr1), Value: cdc.MustMarshal(&height)},
+			{Key: types.MakeERC20ToDenomKey(ethAddr1), Value: []byte("ustake")},
+			{Key: []byte{types.DefaultEVMChainIDKey}, Value: sdk.Uint64ToBigEndian(137)},
+			{Key: types.MakeIBCForwardKey(accAddr1), Value: cdc.MustMarshal(&forward)},
+			{Key: types.MakeERC20MetadataVoteKey(ethAddr1, valAddr1), Value: cdc.MustMarshal(&metadata)},
+			{Key: append(types.MakeEVMChainStoreKey(137), types.MakeSendToEthereumKey(send.Id, send.Erc20Fee)...), Value: cdc.MustMarshal(&send)},
+			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+		panics      bool
+	}{
+		{"ValidatorEthereumAddress", fmt.Sprintf("%s\n%s", ethAddr1.Hex(), ethAddr1.Hex()), false},
+		{"OrchestratorValidatorAddress", fmt.Sprintf("%s\n%s", valAddr1, valAddr1), false},
+		{"EthereumOrchestratorAddress", fmt.Sprintf("%s\n%s", accAddr1, accAddr1), false},
+		{"OutgoingTx", fmt.Sprintf("%s\n%s", otxJSON, otxJSON), false},
+		{"SendToEthereum", fmt.Sprintf("%s\n%s", sendJSON, sendJSON), false},
+		{"LastEventNonceByValidator", "5\n5", false},
+		{"EthereumHeightVote", fmt.Sprintf("%s\n%s", heightJSON, heightJSON), false},
+		{"ERC20ToDenom", "ustake\nustake", false},
+		{"DefaultEVMChainID", "137\n137", false},
+		{"IBCForward", fmt.Sprintf("%s\n%s", forwardJSON, forwardJSON), false},
+		{"ERC20MetadataVote", fmt.Sprintf("%s\n%s", metadataJSON, metadataJSON), false},
+		{"EVMChainStore", fmt.Sprintf("%s\n%s", sendJSON, sendJSON), false},
+		{"other", "", true},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
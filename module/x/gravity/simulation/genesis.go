@@ -0,0 +1,184 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// Simulation parameter constants
+const (
+	GravityID                = "gravity_id"
+	BridgeEthereumAddress    = "bridge_ethereum_address"
+	BridgeChainID            = "bridge_chain_id"
+	SignedWindow             = "signed_window"
+	TargetEthTxTimeout       = "target_eth_tx_timeout"
+	AverageBlockTime         = "average_block_time"
+	AverageEthereumBlockTime = "average_ethereum_block_time"
+	SlashFraction            = "slash_fraction"
+	EthereumEventVoteWindow  = "ethereum_event_vote_window"
+	PowerDiffThreshold       = "signer_set_power_diff_threshold"
+	SignerSetMaxAge          = "signer_set_max_age"
+	RotationCooldown         = "delegate_keys_rotation_cooldown"
+	BondDenomERC20           = "bond_denom_erc20"
+)
+
+// RandomEthereumAddress returns a random Ethereum address
+func RandomEthereumAddress(r *rand.Rand) common.Address {
+	var addr common.Address
+	r.Read(addr[:])
+	return addr
+}
+
+// GenSignedWindow randomizes the signing windows. They are kept well above
+// the number of simulated blocks so validators that have not delegated
+// Ethereum keys yet are not all slashed.
+func GenSignedWindow(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 5000, 20000))
+}
+
+// GenTargetEthTxTimeout randomizes the outgoing tx timeout in milliseconds
+func GenTargetEthTxTimeout(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60000, 86400000))
+}
+
+// GenAverageBlockTime randomizes an average block time in milliseconds
+func GenAverageBlockTime(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100, 20000))
+}
+
+// GenSlashFraction randomizes a slash fraction between 0.01% and 1%
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 4)
+}
+
+// GenPowerDiffThreshold randomizes a signer set power diff threshold between 1% and 10%
+func GenPowerDiffThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 11)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for gravity. The bond
+// denom is mapped to a random ERC20 so that it can be sent to Ethereum.
+func RandomizedGenState(simState *module.SimulationState) {
+	var gravityID string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GravityID, &gravityID, simState.Rand,
+		func(r *rand.Rand) { gravityID = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 32)) },
+	)
+
+	var bridgeAddress string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeEthereumAddress, &bridgeAddress, simState.Rand,
+		func(r *rand.Rand) { bridgeAddress = RandomEthereumAddress(r).Hex() },
+	)
+
+	var bridgeChainID uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BridgeChainID, &bridgeChainID, simState.Rand,
+		func(r *rand.Rand) { bridgeChainID = uint64(simtypes.RandIntBetween(r, 1, 100000)) },
+	)
+
+	var signedWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignedWindow, &signedWindow, simState.Rand,
+		func(r *rand.Rand) { signedWindow = GenSignedWindow(r) },
+	)
+
+	var targetEthTxTimeout uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetEthTxTimeout, &targetEthTxTimeout, simState.Rand,
+		func(r *rand.Rand) { targetEthTxTimeout = GenTargetEthTxTimeout(r) },
+	)
+
+	var averageBlockTime uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AverageBlockTime, &averageBlockTime, simState.Rand,
+		func(r *rand.Rand) { averageBlockTime = GenAverageBlockTime(r) },
+	)
+
+	var averageEthereumBlockTime uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AverageEthereumBlockTime, &averageEthereumBlockTime, simState.Rand,
+		func(r *rand.Rand) { averageEthereumBlockTime = GenAverageBlockTime(r) },
+	)
+
+	var slashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFraction, &slashFraction, simState.Rand,
+		func(r *rand.Rand) { slashFraction = GenSlashFraction(r) },
+	)
+
+	var ethereumEventVoteWindow uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EthereumEventVoteWindow, &ethereumEventVoteWindow, simState.Rand,
+		func(r *rand.Rand) { ethereumEventVoteWindow = uint64(simtypes.RandIntBetween(r, 100, 10000)) },
+	)
+
+	var powerDiffThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PowerDiffThreshold, &powerDiffThreshold, simState.Rand,
+		func(r *rand.Rand) { powerDiffThreshold = GenPowerDiffThreshold(r) },
+	)
+
+	var signerSetMaxAge uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SignerSetMaxAge, &signerSetMaxAge, simState.Rand,
+		func(r *rand.Rand) { signerSetMaxAge = uint64(simtypes.RandIntBetween(r, 0, 500)) },
+	)
+
+	var rotationCooldown uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RotationCooldown, &rotationCooldown, simState.Rand,
+		func(r *rand.Rand) { rotationCooldown = uint64(simtypes.RandIntBetween(r, 0, 1000)) },
+	)
+
+	var bondDenomERC20 string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BondDenomERC20, &bondDenomERC20, simState.Rand,
+		func(r *rand.Rand) { bondDenomERC20 = RandomEthereumAddress(r).Hex() },
+	)
+
+	params := types.Params{
+		GravityId:                                 gravityID,
+		BridgeEthereumAddress:                     bridgeAddress,
+		BridgeChainId:                             bridgeChainID,
+		SignedSignerSetTxsWindow:                  signedWindow,
+		SignedBatchesWindow:                       signedWindow,
+		EthereumSignaturesWindow:                  signedWindow,
+		TargetEthTxTimeout:                        targetEthTxTimeout,
+		AverageBlockTime:                          averageBlockTime,
+		AverageEthereumBlockTime:                  averageEthereumBlockTime,
+		SlashFractionSignerSetTx:                  slashFraction,
+		SlashFractionBatch:                        slashFraction,
+		SlashFractionEthereumSignature:            slashFraction,
+		SlashFractionConflictingEthereumSignature: slashFraction,
+		UnbondSlashingSignerSetTxsWindow:          signedWindow,
+		EthereumEventVoteWindow:                   ethereumEventVoteWindow,
+		ConfirmedOutgoingTxWindow:                 signedWindow,
+		SignerSetPowerDiffThreshold:               powerDiffThreshold,
+		SignerSetUnbondingPowerFraction:           sdk.NewDecWithPrec(1, 2),
+		SignerSetMaxAge:                           signerSetMaxAge,
+		DelegateKeysRotationCooldown:              rotationCooldown,
+	}
+
+	gravityGenesis := types.GenesisState{
+		Params: &params,
+		Erc20ToDenoms: []*types.ERC20ToDenom{
+			{Erc20: bondDenomERC20, Denom: sdk.DefaultBondDenom},
+		},
+	}
+
+	bz, err := json.MarshalIndent(&gravityGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated gravity parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&gravityGenesis)
+}
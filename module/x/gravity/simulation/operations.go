@@ -0,0 +1,448 @@
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/keeper"
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendToEthereum               = "op_weight_msg_send_to_ethereum"
+	OpWeightMsgCancelSendToEthereum         = "op_weight_msg_cancel_send_to_ethereum"
+	OpWeightMsgDelegateKeys                 = "op_weight_msg_delegate_keys"
+	OpWeightMsgSubmitEthereumEvent          = "op_weight_msg_submit_ethereum_event"
+	OpWeightMsgSubmitEthereumTxConfirmation = "op_weight_msg_submit_ethereum_tx_confirmation"
+
+	DefaultWeightMsgSendToEthereum               = 100
+	DefaultWeightMsgCancelSendToEthereum         = 20
+	DefaultWeightMsgDelegateKeys                 = 50
+	DefaultWeightMsgSubmitEthereumEvent          = 100
+	DefaultWeightMsgSubmitEthereumTxConfirmation = 100
+)
+
+// EthereumOriginatedERC20 is the token bridged to Cosmos by simulated
+// SendToCosmosEvents
+var EthereumOriginatedERC20 = common.HexToAddress("0x5EcAd4A3d6a4BA1B2d8c4b6a3e0B2D2dd9D6E0b1")
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak simulation.AccountKeeper,
+	bk simulation.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendToEthereum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendToEthereum, &weightMsgSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgSendToEthereum = DefaultWeightMsgSendToEthereum },
+	)
+
+	var weightMsgCancelSendToEthereum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSendToEthereum, &weightMsgCancelSendToEthereum, nil,
+		func(_ *rand.Rand) { weightMsgCancelSendToEthereum = DefaultWeightMsgCancelSendToEthereum },
+	)
+
+	var weightMsgDelegateKeys int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDelegateKeys, &weightMsgDelegateKeys, nil,
+		func(_ *rand.Rand) { weightMsgDelegateKeys = DefaultWeightMsgDelegateKeys },
+	)
+
+	var weightMsgSubmitEthereumEvent int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumEvent, &weightMsgSubmitEthereumEvent, nil,
+		func(_ *rand.Rand) { weightMsgSubmitEthereumEvent = DefaultWeightMsgSubmitEthereumEvent },
+	)
+
+	var weightMsgSubmitEthereumTxConfirmation int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEthereumTxConfirmation, &weightMsgSubmitEthereumTxConfirmation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitEthereumTxConfirmation = DefaultWeightMsgSubmitEthereumTxConfirmation
+		},
+	)
+
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()), tx.DefaultSignModes)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSendToEthereum,
+			SimulateMsgSendToEthereum(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelSendToEthereum,
+			SimulateMsgCancelSendToEthereum(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegateKeys,
+			SimulateMsgDelegateKeys(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitEthereumEvent,
+			SimulateMsgSubmitEthereumEvent(txConfig, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSubmitEthereumTxConfirmation,
+			SimulateMsgSubmitEthereumTxConfirmation(txConfig, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSendToEthereum generates a MsgSendToEthereum of a random bridged
+// coin held by a random account
+func SimulateMsgSendToEthereum(txConfig client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSendToEthereum{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		var bridged sdk.Coins
+		for _, coin := range bk.SpendableCoins(ctx, simAccount.Address) {
+			if _, _, err := k.DenomToERC20Lookup(ctx, coin.Denom); err == nil && coin.Amount.GT(sdk.OneInt()) {
+				bridged = append(bridged, coin)
+			}
+		}
+		if len(bridged) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bridged coins to send"), nil, nil
+		}
+
+		coin := bridged[r.Intn(len(bridged))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(2))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		fee := simtypes.RandomAmount(r, coin.Amount.Sub(amount).QuoRaw(2))
+
+		msg := types.NewMsgSendToEthereum(
+			simAccount.Address,
+			RandomEthereumAddress(r).Hex(),
+			sdk.NewCoin(coin.Denom, amount),
+			sdk.NewCoin(coin.Denom, fee),
+		)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(msg.Amount.Add(msg.BridgeFee)),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgCancelSendToEthereum generates a MsgCancelSendToEthereum for a
+// random unbatched send to Ethereum
+func SimulateMsgCancelSendToEthereum(txConfig client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCancelSendToEthereum{})
+
+		var sends []*types.SendToEthereum
+		k.IterateUnbatchedSendToEthereums(ctx, func(ste *types.SendToEthereum) bool {
+			sends = append(sends, ste)
+			return false
+		})
+		if len(sends) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unbatched sends to Ethereum"), nil, nil
+		}
+
+		send := sends[r.Intn(len(sends))]
+		simAccount, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(send.Sender))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgCancelSendToEthereum(send.Id, simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDelegateKeys generates a MsgDelegateKeys for a random validator
+// without delegate keys. The validator's operator account is used as its
+// orchestrator and its Ethereum key is derived from the account's private key.
+func SimulateMsgDelegateKeys(txConfig client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgDelegateKeys{})
+
+		var candidates []simtypes.Account
+		k.StakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+			if k.GetValidatorEthereumAddress(ctx, validator.GetOperator()) != (common.Address{}) {
+				return false
+			}
+			if simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator())); found {
+				candidates = append(candidates, simAccount)
+			}
+			return false
+		})
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no validators without delegate keys"), nil, nil
+		}
+
+		simAccount := candidates[r.Intn(len(candidates))]
+		ethKey := ethereumKey(simAccount)
+		ethAddr := crypto.PubkeyToAddress(ethKey.PublicKey)
+		if k.GetEthereumOrchestratorAddress(ctx, ethAddr) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "ethereum address in use"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		signMsg := types.DelegateKeysSignMsg{
+			ValidatorAddress: sdk.ValAddress(simAccount.Address).String(),
+			Nonce:            account.GetSequence(),
+		}
+		signMsgBz, err := signMsg.Marshal()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to marshal sign msg"), nil, err
+		}
+		ethSig, err := types.NewEthereumSignature(crypto.Keccak256Hash(signMsgBz).Bytes(), ethKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign delegate keys"), nil, err
+		}
+
+		msg := types.NewMsgDelegateKeys(sdk.ValAddress(simAccount.Address), simAccount.Address, ethAddr.Hex(), ethSig)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSubmitEthereumEvent generates a MsgSubmitEthereumEvent voting for
+// the next event of a random bonded validator. Events are derived from their
+// nonce so that all validators vote for the same event at each nonce.
+func SimulateMsgSubmitEthereumEvent(txConfig client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitEthereumEvent{})
+
+		simAccount, found := randomBondedValidatorAccount(r, ctx, accs, k, false)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validators"), nil, nil
+		}
+
+		res, err := k.LastSubmittedEthereumEvent(
+			sdk.WrapSDKContext(ctx),
+			&types.LastSubmittedEthereumEventRequest{Address: simAccount.Address.String()},
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query last submitted event"), nil, err
+		}
+
+		event, err := types.PackEvent(sendToCosmosEvent(res.EventNonce+1, accs))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack event"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumEvent{
+			Event:  event,
+			Signer: simAccount.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSubmitEthereumTxConfirmation generates a MsgSubmitEthereumTxConfirmation
+// signing a random outgoing tx not yet signed by a random bonded validator
+// with delegate keys
+func SimulateMsgSubmitEthereumTxConfirmation(txConfig client.TxConfig, ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSubmitEthereumTxConfirmation{})
+
+		simAccount, found := randomBondedValidatorAccount(r, ctx, accs, k, true)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validators with delegate keys"), nil, nil
+		}
+
+		valAddr := sdk.ValAddress(simAccount.Address)
+		ethKey := ethereumKey(simAccount)
+		ethAddr := crypto.PubkeyToAddress(ethKey.PublicKey)
+		if k.GetValidatorEthereumAddress(ctx, valAddr) != ethAddr {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator ethereum key is not simulated"), nil, nil
+		}
+
+		var unsigned []types.OutgoingTx
+		for _, sstx := range k.GetUnsignedSignerSetTxs(ctx, valAddr) {
+			unsigned = append(unsigned, sstx)
+		}
+		for _, btx := range k.GetUnsignedBatchTxs(ctx, valAddr) {
+			unsigned = append(unsigned, btx)
+		}
+		for _, cctx := range k.GetUnsignedContractCallTxs(ctx, valAddr) {
+			unsigned = append(unsigned, cctx)
+		}
+		if len(unsigned) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unsigned outgoing txs"), nil, nil
+		}
+
+		otx := unsigned[r.Intn(len(unsigned))]
+		signature, err := types.NewEthereumSignature(otx.GetCheckpoint([]byte(k.GetParams(ctx).GravityId)), ethKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign outgoing tx"), nil, err
+		}
+
+		var confirmation types.EthereumTxConfirmation
+		switch otx := otx.(type) {
+		case *types.SignerSetTx:
+			confirmation = &types.SignerSetTxConfirmation{
+				SignerSetNonce: otx.Nonce,
+				EthereumSigner: ethAddr.Hex(),
+				Signature:      signature,
+			}
+		case *types.BatchTx:
+			confirmation = &types.BatchTxConfirmation{
+				TokenContract:  otx.TokenContract,
+				BatchNonce:     otx.BatchNonce,
+				EthereumSigner: ethAddr.Hex(),
+				Signature:      signature,
+			}
+		case *types.ContractCallTx:
+			confirmation = &types.ContractCallTxConfirmation{
+				InvalidationScope: otx.InvalidationScope,
+				InvalidationNonce: otx.InvalidationNonce,
+				EthereumSigner:    ethAddr.Hex(),
+				Signature:         signature,
+			}
+		}
+
+		packed, err := types.PackConfirmation(confirmation)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack confirmation"), nil, err
+		}
+
+		msg := &types.MsgSubmitEthereumTxConfirmation{
+			Confirmation: packed,
+			Signer:       simAccount.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomBondedValidatorAccount returns the operator account of a random bonded
+// validator, optionally only among those with delegate keys
+func randomBondedValidatorAccount(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account, k keeper.Keeper, withKeys bool) (simtypes.Account, bool) {
+	var candidates []simtypes.Account
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		valAddr := validator.GetOperator()
+		if withKeys && k.GetValidatorEthereumAddress(ctx, valAddr) == (common.Address{}) {
+			continue
+		}
+		if simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr)); found {
+			candidates = append(candidates, simAccount)
+		}
+	}
+	if len(candidates) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	return candidates[r.Intn(len(candidates))], true
+}
+
+// ethereumKey derives the simulated Ethereum key of a validator from the
+// private key of its operator account
+func ethereumKey(simAccount simtypes.Account) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(crypto.Keccak256(simAccount.PrivKey.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// sendToCosmosEvent returns the simulated deposit of EthereumOriginatedERC20
+// observed at the given event nonce
+func sendToCosmosEvent(nonce uint64, accs []simtypes.Account) *types.SendToCosmosEvent {
+	return &types.SendToCosmosEvent{
+		EventNonce:     nonce,
+		TokenContract:  EthereumOriginatedERC20.Hex(),
+		Amount:         sdk.NewIntFromUint64(1000000 * (nonce%10 + 1)),
+		EthereumSender: common.BigToAddress(new(big.Int).SetUint64(nonce)).Hex(),
+		CosmosReceiver: accs[nonce%uint64(len(accs))].Address.String(),
+		EthereumHeight: nonce,
+	}
+}
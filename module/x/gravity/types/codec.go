@@ -48,6 +48,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitEthereumTxConfirmation{},
 		&MsgDelegateKeys{},
 		&MsgEthereumHeightVote{},
+		&MsgResyncEventNonce{},
+		&MsgRequestERC20Deployment{},
+		&MsgMigrateBridgeContract{},
+		&MsgUpdateParams{},
+		&MsgRotateDelegateKeys{},
+		&MsgAddOrchestrator{},
+		&MsgRemoveOrchestrator{},
+		&MsgAddEVMChain{},
+		&MsgRegisterIBCForward{},
+		&MsgERC20MetadataVote{},
 	)
 
 	registry.RegisterInterface(
@@ -78,6 +88,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&CommunityPoolEthereumSpendProposal{},
+		&ERC20MetadataProposal{},
+		&IBCDenomMetadataProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Signature versions of MsgDelegateKeys
+const (
+	// DelegateKeysSignatureVersionProto signs the Keccak256 hash of the proto
+	// encoded DelegateKeysSignMsg
+	DelegateKeysSignatureVersionProto uint32 = 0
+	// DelegateKeysSignatureVersionEIP712 signs the DelegateKeys EIP-712 typed
+	// data returned by DelegateKeysTypedData
+	DelegateKeysSignatureVersionEIP712 uint32 = 1
+)
+
+const (
+	// EIP712DomainName is the name of the EIP-712 domain of gravity typed data
+	EIP712DomainName = "Gravity Bridge"
+	// EIP712DomainVersion is the version of the EIP-712 domain of gravity typed data
+	EIP712DomainVersion = "1"
+)
+
+// DelegateKeysTypedData returns the EIP-712 typed data an operator signs with
+// its Ethereum key when submitting a MsgDelegateKeys with signature version
+// DelegateKeysSignatureVersionEIP712. The nonce is the current sequence of the
+// validator account and the chain id is the bridge chain id of the default
+// EVM chain.
+func DelegateKeysTypedData(validatorAddress string, nonce uint64, chainID uint64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"DelegateKeys": {
+				{Name: "validatorAddress", Type: "string"},
+				{Name: "nonce", Type: "uint64"},
+			},
+		},
+		PrimaryType: "DelegateKeys",
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712DomainName,
+			Version: EIP712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+		},
+		Message: apitypes.TypedDataMessage{
+			"validatorAddress": validatorAddress,
+			"nonce":            strconv.FormatUint(nonce, 10),
+		},
+	}
+}
+
+// DelegateKeysTypedDataHash returns the EIP-712 hash of the DelegateKeys typed
+// data, which is signed without the Ethereum signed message prefix
+func DelegateKeysTypedDataHash(validatorAddress string, nonce uint64, chainID uint64) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(DelegateKeysTypedData(validatorAddress, nonce, chainID))
+	return hash, err
+}
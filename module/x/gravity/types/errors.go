@@ -16,4 +16,10 @@ var (
 	ErrEthereumProposalDenomMismatch    = errors.Register(ModuleName, 11, "community pool Ethereum spend proposal amount and bridge fee denom mismatch")
 	ErrInvalidValidatorAddress          = errors.Register(ModuleName, 12, "invalid validator address")
 	ErrInvalidOrchestratorAddress       = errors.Register(ModuleName, 13, "invalid orchestrator address")
+	ErrERC20NotDeployed                 = errors.Register(ModuleName, 14, "no ERC20 deployed for denom")
+	ErrInvalidERC20MetadataProposal     = errors.Register(ModuleName, 15, "invalid ERC20 metadata proposal")
+	ErrInvalidIBCDenomMetadataProposal  = errors.Register(ModuleName, 16, "invalid IBC denom metadata proposal")
+	ErrBridgeMigrationInProgress        = errors.Register(ModuleName, 17, "bridge contract migration in progress")
+	ErrBridgePaused                     = errors.Register(ModuleName, 18, "bridge paused after a signer set hijack")
+	ErrUnknownEVMChain                  = errors.Register(ModuleName, 19, "unknown evm chain")
 )
@@ -3,9 +3,12 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -65,6 +68,21 @@ func GravityDenom(contract common.Address) string {
 	return strings.Join([]string{GravityDenomPrefix, contract.Hex()}, GravityDenomSeparator)
 }
 
+// Validate checks that the contract is an ethereum address and that the
+// amount fits the uint256 used by ERC20 contracts
+func (e ERC20Token) Validate() error {
+	if !common.IsHexAddress(e.Contract) {
+		return fmt.Errorf("invalid erc20 contract address %s", e.Contract)
+	}
+	if e.Amount.IsNil() || e.Amount.IsNegative() {
+		return fmt.Errorf("invalid erc20 amount %s", e.Amount)
+	}
+	if e.Amount.BigInt().BitLen() > 256 {
+		return fmt.Errorf("erc20 amount %s overflows uint256", e.Amount)
+	}
+	return nil
+}
+
 // GravityCoin returns the gravity representation of the ERC20
 func (e ERC20Token) GravityCoin() sdk.Coin {
 	return sdk.Coin{Amount: e.Amount, Denom: GravityDenom(common.HexToAddress(e.Contract))}
@@ -86,6 +104,30 @@ func GravityDenomToERC20(denom string) (string, error) {
 	}
 }
 
+// EVMChainGravityDenom returns the voucher denom of an ERC20 of an EVM chain
+// other than the default one, which keeps the GravityDenom vouchers:
+// gravity/137/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5
+func EVMChainGravityDenom(evmChainID uint64, contract common.Address) string {
+	return strings.Join([]string{GravityDenomPrefix, strconv.FormatUint(evmChainID, 10), contract.Hex()}, "/")
+}
+
+// EVMChainGravityDenomToERC20 returns the EVM chain id and the ERC20 of a
+// voucher denom created by EVMChainGravityDenom
+func EVMChainGravityDenomToERC20(denom string) (uint64, string, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != GravityDenomPrefix {
+		return 0, "", fmt.Errorf("denom %s is not an evm chain gravity denom", denom)
+	}
+	evmChainID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil || evmChainID == 0 || strconv.FormatUint(evmChainID, 10) != parts[1] {
+		return 0, "", fmt.Errorf("invalid evm chain id %s in denom %s", parts[1], denom)
+	}
+	if len(parts[2]) != EthereumContractAddressLen || !common.IsHexAddress(parts[2]) {
+		return 0, "", fmt.Errorf("error validating ethereum contract address")
+	}
+	return evmChainID, common.HexToAddress(parts[2]).Hex(), nil
+}
+
 func NormalizeCoinDenom(coin *sdk.Coin) {
 	coin.Denom = NormalizeDenom(coin.Denom)
 }
@@ -94,10 +136,74 @@ func NormalizeDenom(denom string) string {
 	if contract, err := GravityDenomToERC20(denom); err == nil {
 		return GravityDenom(common.HexToAddress(contract))
 	}
+	if evmChainID, contract, err := EVMChainGravityDenomToERC20(denom); err == nil {
+		return EVMChainGravityDenom(evmChainID, common.HexToAddress(contract))
+	}
 
 	return denom
 }
 
+// ValidateBasic checks that the decimals fit the uint8 used by ERC20 contracts
+func (m ERC20Metadata) ValidateBasic() error {
+	if m.Decimals > math.MaxUint8 {
+		return fmt.Errorf("erc20 decimals %d exceed %d", m.Decimals, math.MaxUint8)
+	}
+	return nil
+}
+
+// ValidateBasic checks that the migration targets an ethereum address and a
+// deployment height from which the new contract events can be replayed
+func (m BridgeMigration) ValidateBasic() error {
+	if !common.IsHexAddress(m.NewBridgeEthereumAddress) {
+		return fmt.Errorf("invalid new bridge ethereum address %s", m.NewBridgeEthereumAddress)
+	}
+	if m.BridgeDeploymentHeight == 0 {
+		return fmt.Errorf("bridge deployment height must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on a signer set hijack incident
+func (m SignerSetHijackIncident) ValidateBasic() error {
+	if m.SignerSetTxNonce == 0 {
+		return fmt.Errorf("signer set tx nonce must be positive")
+	}
+	if m.ObservedSignersHash == "" {
+		return fmt.Errorf("observed signers hash cannot be empty")
+	}
+	if m.ExpectedSignersHash == m.ObservedSignersHash {
+		return fmt.Errorf("expected and observed signers hashes match")
+	}
+	if m.ResolvedHeight != 0 && m.ResolvedHeight < m.Height {
+		return fmt.Errorf("resolved height %d is before the detection height %d", m.ResolvedHeight, m.Height)
+	}
+	for i, member := range m.ObservedMembers {
+		if err := member.ValidateBasic(); err != nil {
+			return fmt.Errorf("observed member %d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// BankMetadata returns the bank metadata for the gravity voucher denom of an
+// ERC20 with this metadata. The voucher denom is the base unit and the symbol
+// is the display unit, scaled by the ERC20 decimals.
+func (m ERC20Metadata) BankMetadata(denom string) banktypes.Metadata {
+	md := banktypes.Metadata{
+		Description: fmt.Sprintf("Gravity Bridge voucher for the %s ERC20", m.Name),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+	}
+	if m.Decimals > 0 {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: m.Symbol, Exponent: uint32(m.Decimals)})
+		md.Display = m.Symbol
+	}
+	return md
+}
+
 func NewSendToEthereumTx(id uint64, tokenContract common.Address, sender sdk.AccAddress, recipient common.Address, amount, feeAmount uint64) *SendToEthereum {
 	return &SendToEthereum{
 		Id:                id,
@@ -41,6 +41,23 @@ func decodeSignature(sig []byte) (r, s *big.Int, v byte) {
 // ValidateEthereumSignature takes a message, an associated signature and public key and
 // returns an error if the signature isn't valid
 func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.Address) error {
+	protectedHash := crypto.Keccak256Hash(append([]uint8(signaturePrefix), hash...))
+	return validateSignature(protectedHash.Bytes(), signature, ethAddress)
+}
+
+// ValidateEIP712Signature returns an error if the signature isn't a valid
+// signature of the EIP-712 typed data hash by the ethereum address. Unlike
+// ValidateEthereumSignature, the hash is signed without the Ethereum signed
+// message prefix.
+func ValidateEIP712Signature(typedDataHash []byte, signature []byte, ethAddress common.Address) error {
+	if len(typedDataHash) != 32 {
+		return errors.Wrapf(ErrInvalid, "typed data hash must be 32 bytes, got %d", len(typedDataHash))
+	}
+	return validateSignature(typedDataHash, signature, ethAddress)
+}
+
+// validateSignature checks the signature of the signed hash
+func validateSignature(hash []byte, signature []byte, ethAddress common.Address) error {
 
 	/// signature to public key: invalid signature length: invalid
 	/// signature not matching: invalid: invalid
@@ -75,9 +92,7 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 		sigCopy[64] -= 27
 	}
 
-	hash = append([]uint8(signaturePrefix), hash...)
-
-	pubkey, err := crypto.SigToPub(crypto.Keccak256Hash(hash).Bytes(), sigCopy)
+	pubkey, err := crypto.SigToPub(hash, sigCopy)
 	if err != nil {
 		return errors.Wrapf(err, "signature to public key sig %x hash %x", sigCopy, hash)
 	}
@@ -88,3 +103,42 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress common.
 
 	return nil
 }
+
+// NewEthereumTxConfirmation signs the checkpoint of the outgoing tx for the
+// gravity id and returns the confirmation of the signing ethereum key
+func NewEthereumTxConfirmation(otx OutgoingTx, gravityID string, privateKey *ecdsa.PrivateKey) (EthereumTxConfirmation, error) {
+	if privateKey == nil {
+		return nil, errors.Wrap(ErrInvalid, "did not pass in private key")
+	}
+
+	signature, err := NewEthereumSignature(otx.GetCheckpoint([]byte(gravityID)), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	switch tx := otx.(type) {
+	case *SignerSetTx:
+		return &SignerSetTxConfirmation{
+			SignerSetNonce: tx.Nonce,
+			EthereumSigner: signer,
+			Signature:      signature,
+		}, nil
+	case *BatchTx:
+		return &BatchTxConfirmation{
+			TokenContract:  tx.TokenContract,
+			BatchNonce:     tx.BatchNonce,
+			EthereumSigner: signer,
+			Signature:      signature,
+		}, nil
+	case *ContractCallTx:
+		return &ContractCallTxConfirmation{
+			InvalidationScope: tx.InvalidationScope,
+			InvalidationNonce: tx.InvalidationNonce,
+			EthereumSigner:    signer,
+			Signature:         signature,
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalid, "cannot confirm outgoing tx %T", otx)
+	}
+}
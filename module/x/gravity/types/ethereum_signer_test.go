@@ -2,9 +2,11 @@ package types
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +91,64 @@ func TestSignerSetConfirmSig(t *testing.T) {
 		})
 	}
 }
+
+func TestDelegateKeysTypedDataSig(t *testing.T) {
+	const validatorAddress = "cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn"
+
+	hash, err := DelegateKeysTypedDataHash(validatorAddress, 7, 1)
+	require.NoError(t, err)
+
+	// the EIP-712 encoding, computed independently
+	word := func(v uint64) []byte { return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32) }
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId)")),
+		crypto.Keccak256([]byte("Gravity Bridge")),
+		crypto.Keccak256([]byte("1")),
+		word(1),
+	)
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("DelegateKeys(string validatorAddress,uint64 nonce)")),
+		crypto.Keccak256([]byte(validatorAddress)),
+		word(7),
+	)
+	assert.Equal(t, crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash), hash)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress := crypto.PubkeyToAddress(privKey.PublicKey)
+	sig, err := crypto.Sign(hash, privKey)
+	require.NoError(t, err)
+
+	assert.NoError(t, ValidateEIP712Signature(hash, sig, ethAddress))
+	assert.Error(t, ValidateEIP712Signature(hash, sig, common.HexToAddress("0xc783df8a850f42e7F7e57013759C285caa701eB6")))
+	// typed data is signed without the Ethereum signed message prefix
+	assert.Error(t, ValidateEthereumSignature(hash, sig, ethAddress))
+
+	otherHash, err := DelegateKeysTypedDataHash(validatorAddress, 7, 5)
+	require.NoError(t, err)
+	assert.Error(t, ValidateEIP712Signature(otherHash, sig, ethAddress))
+}
+
+func TestNewEthereumTxConfirmation(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	outgoingTxs := []OutgoingTx{
+		NewSignerSetTx(3, 100, EthereumSigners{{Power: 1, EthereumAddress: ethAddr.Hex()}}),
+		&BatchTx{BatchNonce: 4, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Timeout: 1000},
+		&ContractCallTx{InvalidationScope: []byte("scope"), InvalidationNonce: 5, Timeout: 1000},
+	}
+	for _, otx := range outgoingTxs {
+		conf, err := NewEthereumTxConfirmation(otx, "testgravityid", privKey)
+		require.NoError(t, err)
+		require.NoError(t, conf.Validate())
+		require.Equal(t, ethAddr, conf.GetSigner())
+		// the confirmation confirms the outgoing tx it was signed for
+		require.Equal(t, otx.GetStoreIndex(), conf.GetStoreIndex())
+		require.NoError(t, ValidateEthereumSignature(otx.GetCheckpoint([]byte("testgravityid")), conf.GetSignature(), ethAddr))
+	}
+
+	_, err = NewEthereumTxConfirmation(outgoingTxs[0], "testgravityid", nil)
+	require.Error(t, err)
+}
@@ -10,6 +10,10 @@ const (
 	EventTypeBridgeDepositReceived    = "deposit_received"
 	EventTypeBridgeWithdrawCanceled   = "withdraw_canceled"
 	EventTypeContractCallTxCompleted  = "contract_call_tx_completed"
+	EventTypeEventNonceResynced       = "event_nonce_resynced"
+	EventTypeIBCForward               = "ibc_forward"
+	EventTypeIBCForwardFailed         = "ibc_forward_failed"
+	EventTypeERC20DeploymentRequested = "erc20_deployment_requested"
 
 	AttributeKeyEthereumEventVoteRecordID     = "ethereum_event_vote_record_id"
 	AttributeKeyBatchConfirmKey               = "batch_confirm_key"
@@ -24,6 +28,7 @@ const (
 	AttributeKeyBridgeChainID                 = "bridge_chain_id"
 	AttributeKeySetOrchestratorAddr           = "set_orchestrator_address"
 	AttributeKeySetEthereumAddr               = "set_ethereum_address"
+	AttributeKeyRemovedOrchestratorAddr       = "removed_orchestrator_address"
 	AttributeKeyValidatorAddr                 = "validator_address"
 	AttributeKeyContractCallInvalidationScope = "contract_call_invalidation_scope"
 	AttributeKeyContractCallInvalidationNonce = "contract_call_invalidation_nonce"
@@ -32,6 +37,18 @@ const (
 	AttributeKeyContractCallFees              = "contract_call_fees"
 	AttributeKeyContractCallAddress           = "contract_call_address"
 	AttributeKeyEthTxTimeout                  = "eth_tx_timeout"
+	AttributeKeyPreviousEventNonce            = "previous_event_nonce"
+	AttributeKeyIBCForwardAccount             = "ibc_forward_account"
+	AttributeKeyIBCForwardReceiver            = "ibc_forward_receiver"
+	AttributeKeyIBCForwardChannel             = "ibc_forward_channel"
+	AttributeKeyIBCForwardFallback            = "ibc_forward_fallback"
+	AttributeKeyIBCForwardAmount              = "ibc_forward_amount"
+	AttributeKeyIBCForwardError               = "ibc_forward_error"
+	AttributeKeyCosmosDenom                   = "cosmos_denom"
+	AttributeKeyERC20Name                     = "erc20_name"
+	AttributeKeyERC20Symbol                   = "erc20_symbol"
+	AttributeKeyERC20Decimals                 = "erc20_decimals"
+	AttributeKeyERC20Contract                 = "erc20_contract"
 
 	// slashing reasons
 	AttributeMissingSignerSetSignature = "missing_signer_set_signature"
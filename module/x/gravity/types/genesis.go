@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	"cosmossdk.io/errors"
@@ -82,17 +83,34 @@ func (gs *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, otx := range gs.CompletedOutgoingTxs {
+		var outgoing OutgoingTx
+		if err := unpacker.UnpackAny(otx, &outgoing); err != nil {
+			return err
+		}
+	}
 	for _, sig := range gs.Confirmations {
 		var signature EthereumTxConfirmation
 		if err := unpacker.UnpackAny(sig, &signature); err != nil {
 			return err
 		}
 	}
+	for _, vc := range gs.FormerKeyConfirmations {
+		var signature EthereumTxConfirmation
+		if err := unpacker.UnpackAny(vc.Confirmation, &signature); err != nil {
+			return err
+		}
+	}
 	for _, evr := range gs.EthereumEventVoteRecords {
 		if err := evr.UnpackInterfaces(unpacker); err != nil {
 			return err
 		}
 	}
+	for _, chain := range gs.AdditionalEvmChains {
+		if err := chain.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -101,21 +119,457 @@ func EventVoteRecordPowerThreshold(totalPower sdk.Int) sdk.Int {
 }
 
 // ValidateBasic validates genesis state by looping through the params and
-// calling their validation functions
+// calling their validation functions, then checking that the outgoing txs,
+// confirmations, event vote records and ERC20 mappings are consistent with
+// each other
 func (s GenesisState) ValidateBasic() error {
+	if s.Params == nil {
+		return fmt.Errorf("params must be set")
+	}
 	if err := s.Params.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "params")
 	}
-	if len(s.DelegateKeys) != 0 {
-		for _, delegateKey := range s.DelegateKeys {
-			if err := delegateKey.ValidateBasic(); err != nil {
-				return errors.Wrap(err, "delegates")
+
+	// the validator of each delegate ethereum key
+	ethereumSigners := make(map[common.Address]string, len(s.DelegateKeys))
+	for _, delegateKey := range s.DelegateKeys {
+		if err := delegateKey.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "delegates")
+		}
+		ethereumSigners[common.HexToAddress(delegateKey.EthereumAddress)] = delegateKey.ValidatorAddress
+	}
+
+	if err := s.validateEVMChainState(ethereumSigners); err != nil {
+		return err
+	}
+	if err := s.validateAdditionalOrchestrators(); err != nil {
+		return errors.Wrap(err, "additional orchestrators")
+	}
+	if err := s.validateDelegateKeysRotationHeights(); err != nil {
+		return errors.Wrap(err, "delegate keys rotation heights")
+	}
+	if err := s.validateIBCForwards(); err != nil {
+		return errors.Wrap(err, "ibc forwards")
+	}
+	if err := s.validateAdditionalEVMChains(ethereumSigners); err != nil {
+		return errors.Wrap(err, "additional evm chains")
+	}
+
+	return nil
+}
+
+// validateEVMChainState validates the state of a single EVM chain, which is
+// signed by the ethereum keys of the delegates
+func (s GenesisState) validateEVMChainState(ethereumSigners map[common.Address]string) error {
+	outgoingTxs, err := validateOutgoingTxs(s.OutgoingTxs)
+	if err != nil {
+		return errors.Wrap(err, "outgoing txs")
+	}
+	completedOutgoingTxs, err := validateOutgoingTxs(s.CompletedOutgoingTxs)
+	if err != nil {
+		return errors.Wrap(err, "completed outgoing txs")
+	}
+	// the signatures of completed txs are kept until they can no longer be slashed for
+	for storeIndex, otx := range completedOutgoingTxs {
+		if _, ok := outgoingTxs[storeIndex]; ok {
+			return errors.Wrapf(ErrInvalid, "completed outgoing txs: %s is also an outgoing tx", describeStoreIndex([]byte(storeIndex)))
+		}
+		outgoingTxs[storeIndex] = otx
+	}
+	if err := s.validateConfirmations(outgoingTxs, ethereumSigners); err != nil {
+		return errors.Wrap(err, "confirmations")
+	}
+	if err := s.validateEthereumEventVoteRecords(); err != nil {
+		return errors.Wrap(err, "ethereum event vote records")
+	}
+	if err := s.validateERC20ToDenoms(); err != nil {
+		return errors.Wrap(err, "erc20 to denoms")
+	}
+	if s.BridgeMigration != nil {
+		if err := s.BridgeMigration.ValidateBasic(); err != nil {
+			return errors.Wrap(ErrInvalid, fmt.Sprintf("bridge migration: %s", err))
+		}
+	}
+	if err := s.validateSignerSetHijackIncidents(); err != nil {
+		return errors.Wrap(err, "signer set hijack incidents")
+	}
+	if err := s.validateValidatorHeightsAndNonces(); err != nil {
+		return err
+	}
+	if err := s.validateERC20MetadataVotes(); err != nil {
+		return errors.Wrap(err, "erc20 metadata votes")
+	}
+
+	return nil
+}
+
+// validateAdditionalEVMChains checks that each additional EVM chain has its own
+// chain id and gravity id, so that signatures cannot be replayed on another
+// chain, and only holds chain scoped state
+func (s GenesisState) validateAdditionalEVMChains(ethereumSigners map[common.Address]string) error {
+	chainIDs := map[uint64]bool{s.Params.BridgeChainId: true}
+	gravityIDs := map[string]bool{s.Params.GravityId: true}
+	for i, chain := range s.AdditionalEvmChains {
+		if chain.Params == nil {
+			return errors.Wrapf(ErrInvalid, "evm chain %d: params must be set", i)
+		}
+		if err := chain.Params.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "evm chain %d: params", i)
+		}
+
+		chainID := chain.Params.BridgeChainId
+		switch {
+		case chainID == 0:
+			return errors.Wrapf(ErrInvalid, "evm chain %d: chain id cannot be 0", i)
+		case chainIDs[chainID]:
+			return errors.Wrapf(ErrInvalid, "evm chain %d: duplicate chain id %d", i, chainID)
+		case gravityIDs[chain.Params.GravityId]:
+			return errors.Wrapf(ErrInvalid, "evm chain %d: duplicate gravity id %s", i, chain.Params.GravityId)
+		case len(chain.DelegateKeys) > 0, len(chain.AdditionalOrchestrators) > 0, len(chain.IbcDenomMetadata) > 0, len(chain.AdditionalEvmChains) > 0,
+			chain.LastUnbondingBlockHeight != 0, len(chain.DelegateKeysRotationHeights) > 0, chain.LastEthereumKeyRotationHeight != 0, len(chain.IbcForwards) > 0:
+			return errors.Wrapf(ErrInvalid, "evm chain %d: delegate keys and their rotations, IBC denom metadata, IBC forwards, the last unbonding height and evm chains are shared by all chains", chainID)
+		}
+		chainIDs[chainID] = true
+		gravityIDs[chain.Params.GravityId] = true
+
+		if err := chain.validateEVMChainState(ethereumSigners); err != nil {
+			return errors.Wrapf(err, "evm chain %d", chainID)
+		}
+	}
+
+	return nil
+}
+
+// validateOutgoingTxs unpacks and validates the outgoing txs and returns them
+// by store index
+func validateOutgoingTxs(otas []*cdctypes.Any) (map[string]OutgoingTx, error) {
+	outgoingTxs := make(map[string]OutgoingTx, len(otas))
+	for i, ota := range otas {
+		otx, err := UnpackOutgoingTx(ota)
+		if err != nil {
+			return nil, errors.Wrapf(err, "outgoing tx %d", i)
+		}
+		if err := otx.Validate(); err != nil {
+			return nil, errors.Wrapf(ErrInvalid, "outgoing tx %d (%s): %s", i, ota.TypeUrl, err)
+		}
+
+		storeIndex := otx.GetStoreIndex()
+		if _, ok := outgoingTxs[string(storeIndex)]; ok {
+			return nil, errors.Wrapf(ErrInvalid, "outgoing tx %d: duplicate %s", i, describeStoreIndex(storeIndex))
+		}
+		outgoingTxs[string(storeIndex)] = otx
+	}
+	return outgoingTxs, nil
+}
+
+// validateConfirmations checks that each confirmation signs the checkpoint of
+// an outgoing or completed tx in the genesis, with the ethereum key of a
+// delegate or, for the former key confirmations, with a key that the validator
+// has since rotated
+func (s GenesisState) validateConfirmations(outgoingTxs map[string]OutgoingTx, ethereumSigners map[common.Address]string) error {
+	delegates := make(map[string]bool, len(ethereumSigners))
+	for _, validator := range ethereumSigners {
+		delegates[validator] = true
+	}
+
+	// the signatures are stored by validator, so each validator signs a tx once
+	// whichever key it used
+	gravityID := []byte(s.Params.GravityId)
+	seen := make(map[string]bool, len(s.Confirmations)+len(s.FormerKeyConfirmations))
+	validateConfirmation := func(confa *cdctypes.Any, validator string) error {
+		conf, err := UnpackConfirmation(confa)
+		if err != nil {
+			return err
+		}
+		if err := conf.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalid, "%s: %s", confa.TypeUrl, err)
+		}
+
+		storeIndex := conf.GetStoreIndex()
+		otx, ok := outgoingTxs[string(storeIndex)]
+		if !ok {
+			return errors.Wrapf(ErrInvalid, "%s is not an outgoing or completed tx in genesis", describeStoreIndex(storeIndex))
+		}
+
+		key := string(storeIndex) + validator
+		if seen[key] {
+			return errors.Wrapf(ErrInvalid, "duplicate signature by %s of %s", validator, describeStoreIndex(storeIndex))
+		}
+		seen[key] = true
+
+		signer := conf.GetSigner()
+		if err := ValidateEthereumSignature(otx.GetCheckpoint(gravityID), conf.GetSignature(), signer); err != nil {
+			return errors.Wrapf(err, "signature by %s of %s", signer.Hex(), describeStoreIndex(storeIndex))
+		}
+		return nil
+	}
+
+	for i, confa := range s.Confirmations {
+		conf, err := UnpackConfirmation(confa)
+		if err != nil {
+			return errors.Wrapf(err, "confirmation %d", i)
+		}
+		validator, ok := ethereumSigners[conf.GetSigner()]
+		if !ok {
+			return errors.Wrapf(ErrInvalid, "confirmation %d: signer %s has no delegate keys", i, conf.GetSigner().Hex())
+		}
+		if err := validateConfirmation(confa, validator); err != nil {
+			return errors.Wrapf(err, "confirmation %d", i)
+		}
+	}
+	for i, vc := range s.FormerKeyConfirmations {
+		if !delegates[vc.ValidatorAddress] {
+			return errors.Wrapf(ErrInvalid, "former key confirmation %d: validator %s has no delegate keys", i, vc.ValidatorAddress)
+		}
+		if err := validateConfirmation(vc.Confirmation, vc.ValidatorAddress); err != nil {
+			return errors.Wrapf(err, "former key confirmation %d", i)
+		}
+	}
+	return nil
+}
+
+// validateEthereumEventVoteRecords checks the events and votes of each record,
+// and that only events up to the last observed event nonce are accepted. Pending
+// records at or below the last observed nonce can never be observed, and are
+// only kept when they lost to a conflicting record accepted at the same nonce.
+func (s GenesisState) validateEthereumEventVoteRecords() error {
+	type record struct {
+		index    int
+		nonce    uint64
+		accepted bool
+	}
+
+	var (
+		records       []record
+		acceptedNonce = make(map[uint64]bool)
+		seen          = make(map[string]bool, len(s.EthereumEventVoteRecords))
+	)
+	for i, evr := range s.EthereumEventVoteRecords {
+		event, err := UnpackEvent(evr.Event)
+		if err != nil {
+			return errors.Wrapf(err, "record %d", i)
+		}
+		if err := event.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalid, "record %d (%s): %s", i, evr.Event.TypeUrl, err)
+		}
+
+		nonce := event.GetEventNonce()
+		key := string(MakeEthereumEventVoteRecordKey(nonce, event.Hash()))
+		if seen[key] {
+			return errors.Wrapf(ErrInvalid, "record %d: duplicate event %s at nonce %d", i, event.Hash(), nonce)
+		}
+		seen[key] = true
+
+		for _, vote := range evr.Votes {
+			if _, err := sdk.ValAddressFromBech32(vote); err != nil {
+				return errors.Wrapf(ErrInvalid, "record %d: invalid vote %s: %s", i, vote, err)
+			}
+		}
+
+		if evr.Accepted {
+			if nonce > s.LastObservedEventNonce {
+				return errors.Wrapf(ErrInvalid, "record %d: accepted event nonce %d is above the last observed event nonce %d", i, nonce, s.LastObservedEventNonce)
 			}
+			acceptedNonce[nonce] = true
+		}
+		records = append(records, record{index: i, nonce: nonce, accepted: evr.Accepted})
+	}
+
+	for _, r := range records {
+		if !r.accepted && r.nonce <= s.LastObservedEventNonce && !acceptedNonce[r.nonce] {
+			return errors.Wrapf(ErrInvalid, "record %d: pending event nonce %d is at or below the last observed event nonce %d", r.index, r.nonce, s.LastObservedEventNonce)
 		}
 	}
+
 	return nil
 }
 
+// validateERC20ToDenoms checks that each cosmos originated denom and ERC20 is
+// mapped at most once
+func (s GenesisState) validateERC20ToDenoms() error {
+	erc20s := make(map[common.Address]bool, len(s.Erc20ToDenoms))
+	denoms := make(map[string]bool, len(s.Erc20ToDenoms))
+	for i, item := range s.Erc20ToDenoms {
+		if !common.IsHexAddress(item.Erc20) {
+			return errors.Wrapf(ErrInvalid, "mapping %d: invalid erc20 address %s", i, item.Erc20)
+		}
+		if err := sdk.ValidateDenom(item.Denom); err != nil {
+			return errors.Wrapf(ErrInvalid, "mapping %d: %s", i, err)
+		}
+
+		erc20 := common.HexToAddress(item.Erc20)
+		if erc20s[erc20] {
+			return errors.Wrapf(ErrInvalid, "mapping %d: erc20 %s is mapped more than once", i, erc20.Hex())
+		}
+		if denoms[item.Denom] {
+			return errors.Wrapf(ErrInvalid, "mapping %d: denom %s is mapped more than once", i, item.Denom)
+		}
+		erc20s[erc20] = true
+		denoms[item.Denom] = true
+	}
+	return nil
+}
+
+// validateSignerSetHijackIncidents checks each incident and rejects incidents
+// sharing the same store key
+func (s GenesisState) validateSignerSetHijackIncidents() error {
+	seen := make(map[string]bool, len(s.SignerSetHijackIncidents))
+	for i, incident := range s.SignerSetHijackIncidents {
+		if err := incident.ValidateBasic(); err != nil {
+			return errors.Wrapf(ErrInvalid, "incident %d: %s", i, err)
+		}
+
+		key := string(MakeSignerSetHijackIncidentKey(incident.Height, incident.EventNonce))
+		if seen[key] {
+			return errors.Wrapf(ErrInvalid, "incident %d: duplicate incident for event nonce %d at height %d", i, incident.EventNonce, incident.Height)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateValidatorHeightsAndNonces checks that the last event nonces and
+// ethereum height votes are recorded at most once per validator
+func (s GenesisState) validateValidatorHeightsAndNonces() error {
+	seen := make(map[string]bool, len(s.LastEventNoncesByValidator))
+	for i, n := range s.LastEventNoncesByValidator {
+		if _, err := sdk.ValAddressFromBech32(n.ValidatorAddress); err != nil {
+			return errors.Wrapf(ErrInvalid, "last event nonce %d: invalid validator %s: %s", i, n.ValidatorAddress, err)
+		}
+		if seen[n.ValidatorAddress] {
+			return errors.Wrapf(ErrInvalid, "last event nonce %d: duplicate validator %s", i, n.ValidatorAddress)
+		}
+		seen[n.ValidatorAddress] = true
+	}
+
+	seen = make(map[string]bool, len(s.EthereumHeightVotes))
+	for i, vote := range s.EthereumHeightVotes {
+		if _, err := sdk.ValAddressFromBech32(vote.ValidatorAddress); err != nil {
+			return errors.Wrapf(ErrInvalid, "ethereum height vote %d: invalid validator %s: %s", i, vote.ValidatorAddress, err)
+		}
+		if seen[vote.ValidatorAddress] {
+			return errors.Wrapf(ErrInvalid, "ethereum height vote %d: duplicate validator %s", i, vote.ValidatorAddress)
+		}
+		seen[vote.ValidatorAddress] = true
+	}
+	return nil
+}
+
+// validateERC20MetadataVotes checks that every ERC20 metadata vote is valid
+// and that validators vote at most once per token contract
+func (s GenesisState) validateERC20MetadataVotes() error {
+	seen := make(map[string]bool, len(s.Erc20MetadataVotes))
+	for i, vote := range s.Erc20MetadataVotes {
+		val, err := sdk.ValAddressFromBech32(vote.ValidatorAddress)
+		if err != nil {
+			return errors.Wrapf(ErrInvalid, "vote %d: invalid validator %s: %s", i, vote.ValidatorAddress, err)
+		}
+		if !common.IsHexAddress(vote.TokenContract) {
+			return errors.Wrapf(ErrInvalid, "vote %d: invalid token contract %s", i, vote.TokenContract)
+		}
+		if err := vote.Metadata.ValidateBasic(); err != nil {
+			return errors.Wrapf(ErrInvalid, "vote %d: %s", i, err)
+		}
+
+		key := string(MakeERC20MetadataVoteKey(common.HexToAddress(vote.TokenContract), val))
+		if seen[key] {
+			return errors.Wrapf(ErrInvalid, "vote %d: duplicate vote of %s for %s", i, vote.ValidatorAddress, vote.TokenContract)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateDelegateKeysRotationHeights checks that each rotation height belongs
+// to a validator with delegate keys, once, and that the last ethereum key
+// rotation is not later than the last delegate keys rotation
+func (s GenesisState) validateDelegateKeysRotationHeights() error {
+	validators := make(map[string]bool, len(s.DelegateKeys))
+	for _, delegateKey := range s.DelegateKeys {
+		validators[delegateKey.ValidatorAddress] = true
+	}
+
+	var lastRotationHeight uint64
+	seen := make(map[string]bool, len(s.DelegateKeysRotationHeights))
+	for i, rotation := range s.DelegateKeysRotationHeights {
+		if !validators[rotation.ValidatorAddress] {
+			return errors.Wrapf(ErrInvalid, "rotation %d: validator %s has no delegate keys", i, rotation.ValidatorAddress)
+		}
+		if seen[rotation.ValidatorAddress] {
+			return errors.Wrapf(ErrInvalid, "rotation %d: duplicate validator %s", i, rotation.ValidatorAddress)
+		}
+		seen[rotation.ValidatorAddress] = true
+		if rotation.Height > lastRotationHeight {
+			lastRotationHeight = rotation.Height
+		}
+	}
+
+	if s.LastEthereumKeyRotationHeight > lastRotationHeight {
+		return errors.Wrapf(ErrInvalid, "last ethereum key rotation height %d is after the last delegate keys rotation height %d", s.LastEthereumKeyRotationHeight, lastRotationHeight)
+	}
+	return nil
+}
+
+// validateAdditionalOrchestrators checks that every additional orchestrator
+// belongs to a validator with delegate keys and that no orchestrator account
+// is used twice
+func (s GenesisState) validateAdditionalOrchestrators() error {
+	validators := make(map[string]int, len(s.DelegateKeys))
+	orchestrators := make(map[string]bool, len(s.DelegateKeys)+len(s.AdditionalOrchestrators))
+	for _, delegateKey := range s.DelegateKeys {
+		validators[delegateKey.ValidatorAddress] = 0
+		orchestrators[delegateKey.OrchestratorAddress] = true
+	}
+
+	for i, orch := range s.AdditionalOrchestrators {
+		if err := orch.ValidateBasic(); err != nil {
+			return errors.Wrapf(ErrInvalid, "orchestrator %d: %s", i, err)
+		}
+
+		count, ok := validators[orch.ValidatorAddress]
+		if !ok {
+			return errors.Wrapf(ErrInvalid, "orchestrator %d: validator %s has no delegate keys", i, orch.ValidatorAddress)
+		}
+		if count == MaxAdditionalOrchestrators {
+			return errors.Wrapf(ErrInvalid, "orchestrator %d: validator %s has more than %d additional orchestrators", i, orch.ValidatorAddress, MaxAdditionalOrchestrators)
+		}
+		validators[orch.ValidatorAddress] = count + 1
+
+		if orchestrators[orch.OrchestratorAddress] {
+			return errors.Wrapf(ErrInvalid, "orchestrator %d: duplicate orchestrator %s", i, orch.OrchestratorAddress)
+		}
+		orchestrators[orch.OrchestratorAddress] = true
+	}
+	return nil
+}
+
+// validateIBCForwards checks that every IBC forward is valid and registered
+// once
+func (s GenesisState) validateIBCForwards() error {
+	accounts := make(map[string]bool, len(s.IbcForwards))
+	for i, forward := range s.IbcForwards {
+		if err := forward.ValidateBasic(); err != nil {
+			return errors.Wrapf(ErrInvalid, "forward %d: %s", i, err)
+		}
+
+		account := IBCForwardAccount(*forward).String()
+		if accounts[account] {
+			return errors.Wrapf(ErrInvalid, "forward %d: duplicate forward of account %s", i, account)
+		}
+		accounts[account] = true
+	}
+	return nil
+}
+
+// describeStoreIndex returns a readable form of an outgoing tx store index
+func describeStoreIndex(storeIndex []byte) string {
+	parts, err := decodeStoreIndex(storeIndex)
+	if err != nil {
+		return fmt.Sprintf("%X", storeIndex)
+	}
+	return strings.Join(parts, "/")
+}
+
 // DefaultGenesisState returns empty genesis state
 // TODO: set some better defaults here
 func DefaultGenesisState() *GenesisState {
@@ -144,6 +598,12 @@ func DefaultParams() *Params {
 		// EthereumEventWindow's units are ethereum blocks. Ethereum block time is ~12 seconds, about twice as long as Sommelier.
 		EthereumEventVoteWindow:   5000,
 		ConfirmedOutgoingTxWindow: 10000,
+
+		SignerSetPowerDiffThreshold:     sdk.NewDecWithPrec(5, 2),
+		SignerSetUnbondingPowerFraction: sdk.NewDecWithPrec(1, 2),
+		SignerSetMaxAge:                 0,
+
+		DelegateKeysRotationCooldown: 10000,
 	}
 }
 
@@ -200,11 +660,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateConfirmedOutgoingTxWindow(p.ConfirmedOutgoingTxWindow); err != nil {
 		return errors.Wrap(err, "confirmed outgoing tx window")
 	}
+	if err := validateSignerSetPowerDiffThreshold(p.SignerSetPowerDiffThreshold); err != nil {
+		return errors.Wrap(err, "signer set power diff threshold")
+	}
+	if err := validateSignerSetUnbondingPowerFraction(p.SignerSetUnbondingPowerFraction); err != nil {
+		return errors.Wrap(err, "signer set unbonding power fraction")
+	}
 
 	return nil
 }
 
-// ParamKeyTable for auth module
+// ParamKeyTable returns the key table of the legacy gravity params subspace,
+// which is only read to migrate the params into the gravity store
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -331,11 +798,11 @@ func validateUnbondSlashingSignerSetTxsWindow(i interface{}) error {
 }
 
 func validateSlashFractionSignerSetTx(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+	v, ok := i.(sdk.Dec)
+	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return nil
+	return validateFraction(v)
 }
 
 func validateSignedBatchesWindow(i interface{}) error {
@@ -355,29 +822,56 @@ func validateEthereumSignaturesWindow(i interface{}) error {
 }
 
 func validateSlashFractionBatch(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+	v, ok := i.(sdk.Dec)
+	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return nil
+	return validateFraction(v)
 }
 
 func validateSlashFractionEthereumSignature(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+	v, ok := i.(sdk.Dec)
+	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	return nil
+	return validateFraction(v)
 }
 
 func validateSlashFractionConflictingEthereumSignature(i interface{}) error {
-	// TODO: do we want to set some bounds on this value?
-	if _, ok := i.(sdk.Dec); !ok {
+	v, ok := i.(sdk.Dec)
+	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	return validateFraction(v)
+}
+
+// validateFraction checks that a fraction is set and between 0 and 1
+func validateFraction(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("fraction must be set")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1, got %s", v)
+	}
 	return nil
 }
 
+func validateSignerSetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateFraction(v)
+}
+
+func validateSignerSetUnbondingPowerFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return validateFraction(v)
+}
+
 func validateEthereumEventVoteWindow(i interface{}) error {
 	if _, ok := i.(uint64); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -6,7 +6,6 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,100 +24,58 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params represent the Gravity genesis and store parameters
-// gravity_id:
-// a random 32 byte value to prevent signature reuse, for example if the
-// cosmos validators decided to use the same Ethereum keys for another chain
-// also running Gravity we would not want it to be possible to play a deposit
-// from chain A back on chain B's Gravity. This value IS USED ON ETHEREUM so
-// it must be set in your genesis.json before launch and not changed after
-// deploying Gravity
-//
-// contract_hash:
-// the code hash of a known good version of the Gravity contract
-// solidity code. This can be used to verify the correct version
-// of the contract has been deployed. This is a reference value for
-// goernance action only it is never read by any Gravity code
-//
-// bridge_ethereum_address:
-// is address of the bridge contract on the Ethereum side, this is a
-// reference value for governance only and is not actually used by any
-// Gravity code
-//
-// bridge_chain_id:
-// the unique identifier of the Ethereum chain, this is a reference value
-// only and is not actually used by any Gravity code
-//
-// These reference values may be used by future Gravity client implemetnations
-// to allow for saftey features or convenience features like the Gravity address
-// in your relayer. A relayer would require a configured Gravity address if
-// governance had not set the address on the chain it was relaying for.
-//
-// signed_signer_set_txs_window
-// signed_batches_window
-// signed_ethereum_signatures_window
-//
-// These values represent the time in blocks that a validator has to submit
-// a signature for a batch or valset, or to submit a ethereum_signature for a
-// particular attestation nonce. In the case of attestations this clock starts
-// when the attestation is created, but only allows for slashing once the event
-// has passed
-//
-// target_eth_tx_timeout:
-//
-// This is the 'target' value for when ethereum transactions time out, this is a
-// target because Ethereum is a probabilistic chain and you can't say for sure
-// what the block frequency is ahead of time.
-//
-// average_block_time
-// average_ethereum_block_time
-//
-// These values are the average Cosmos block time and Ethereum block time
-// respectively and they are used to compute what the target batch timeout is.
-// It is important that governance updates these in case of any major, prolonged
-// change in the time it takes to produce a block
-//
-// slash_fraction_signer_set_tx
-// slash_fraction_batch
-// slash_fraction_ethereum_signature
-// slash_fraction_conflicting_ethereum_signature
+// GenesisState struct
+// TODO: this need to be audited and potentially simplified using the new
+// interfaces
 //
-// The slashing fractions for the various gravity related slashing conditions.
-// The first three refer to not submitting a particular message, the third for
-// submitting a different ethereum_signature for the same Ethereum event
-type Params struct {
-	GravityId                string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash       string `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress    string `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedSignerSetTxsWindow uint64 `protobuf:"varint,6,opt,name=signed_signer_set_txs_window,json=signedSignerSetTxsWindow,proto3" json:"signed_signer_set_txs_window,omitempty"`
-	SignedBatchesWindow      uint64 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	EthereumSignaturesWindow uint64 `protobuf:"varint,8,opt,name=ethereum_signatures_window,json=ethereumSignaturesWindow,proto3" json:"ethereum_signatures_window,omitempty"`
-	TargetEthTxTimeout       uint64 `protobuf:"varint,10,opt,name=target_eth_tx_timeout,json=targetEthTxTimeout,proto3" json:"target_eth_tx_timeout,omitempty"`
-	AverageBlockTime         uint64 `protobuf:"varint,11,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime uint64 `protobuf:"varint,12,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// TODO: slash fraction for contract call txs too
-	SlashFractionSignerSetTx                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_signer_set_tx,json=slashFractionSignerSetTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_signer_set_tx"`
-	SlashFractionBatch                        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionEthereumSignature            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_ethereum_signature,json=slashFractionEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_ethereum_signature"`
-	SlashFractionConflictingEthereumSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_conflicting_ethereum_signature,json=slashFractionConflictingEthereumSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_ethereum_signature"`
-	UnbondSlashingSignerSetTxsWindow          uint64                                 `protobuf:"varint,17,opt,name=unbond_slashing_signer_set_txs_window,json=unbondSlashingSignerSetTxsWindow,proto3" json:"unbond_slashing_signer_set_txs_window,omitempty"`
-	EthereumEventVoteWindow                   uint64                                 `protobuf:"varint,18,opt,name=ethereum_event_vote_window,json=ethereumEventVoteWindow,proto3" json:"ethereum_event_vote_window,omitempty"`
-	ConfirmedOutgoingTxWindow                 uint64                                 `protobuf:"varint,19,opt,name=confirmed_outgoing_tx_window,json=confirmedOutgoingTxWindow,proto3" json:"confirmed_outgoing_tx_window,omitempty"`
-}
-
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+// The top level state is the state of the default EVM chain, together with the
+// delegate keys, their rotation heights, the IBC forwards and the last
+// unbonding block height shared by all chains. The state of the other EVM
+// chains is in additional_evm_chains, without shared state.
+type GenesisState struct {
+	Params                           *Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedEventNonce           uint64                        `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	OutgoingTxs                      []*types.Any                  `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
+	Confirmations                    []*types.Any                  `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
+	EthereumEventVoteRecords         []*EthereumEventVoteRecord    `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
+	DelegateKeys                     []*MsgDelegateKeys            `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
+	Erc20ToDenoms                    []*ERC20ToDenom               `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
+	UnbatchedSendToEthereumTxs       []*SendToEthereum             `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+	Erc20DeploymentRequests          []*ERC20DeploymentRequest     `protobuf:"bytes,13,rep,name=erc20_deployment_requests,json=erc20DeploymentRequests,proto3" json:"erc20_deployment_requests,omitempty"`
+	IbcDenomMetadata                 []*IBCDenomMetadata           `protobuf:"bytes,14,rep,name=ibc_denom_metadata,json=ibcDenomMetadata,proto3" json:"ibc_denom_metadata,omitempty"`
+	BridgeMigration                  *BridgeMigration              `protobuf:"bytes,15,opt,name=bridge_migration,json=bridgeMigration,proto3" json:"bridge_migration,omitempty"`
+	SignerSetHijackIncidents         []*SignerSetHijackIncident    `protobuf:"bytes,16,rep,name=signer_set_hijack_incidents,json=signerSetHijackIncidents,proto3" json:"signer_set_hijack_incidents,omitempty"`
+	AdditionalOrchestrators          []*MsgAddOrchestrator         `protobuf:"bytes,17,rep,name=additional_orchestrators,json=additionalOrchestrators,proto3" json:"additional_orchestrators,omitempty"`
+	AdditionalEvmChains              []*GenesisState               `protobuf:"bytes,18,rep,name=additional_evm_chains,json=additionalEvmChains,proto3" json:"additional_evm_chains,omitempty"`
+	LastEventNoncesByValidator       []*ValidatorEventNonce        `protobuf:"bytes,19,rep,name=last_event_nonces_by_validator,json=lastEventNoncesByValidator,proto3" json:"last_event_nonces_by_validator,omitempty"`
+	LatestSignerSetTxNonce           uint64                        `protobuf:"varint,20,opt,name=latest_signer_set_tx_nonce,json=latestSignerSetTxNonce,proto3" json:"latest_signer_set_tx_nonce,omitempty"`
+	LastSlashedOutgoingTxBlockHeight uint64                        `protobuf:"varint,21,opt,name=last_slashed_outgoing_tx_block_height,json=lastSlashedOutgoingTxBlockHeight,proto3" json:"last_slashed_outgoing_tx_block_height,omitempty"`
+	LastOutgoingBatchNonce           uint64                        `protobuf:"varint,22,opt,name=last_outgoing_batch_nonce,json=lastOutgoingBatchNonce,proto3" json:"last_outgoing_batch_nonce,omitempty"`
+	LastSendToEthereumId             uint64                        `protobuf:"varint,23,opt,name=last_send_to_ethereum_id,json=lastSendToEthereumId,proto3" json:"last_send_to_ethereum_id,omitempty"`
+	LastObservedEthereumBlockHeight  *LatestEthereumBlockHeight    `protobuf:"bytes,24,opt,name=last_observed_ethereum_block_height,json=lastObservedEthereumBlockHeight,proto3" json:"last_observed_ethereum_block_height,omitempty"`
+	LastObservedSignerSet            *SignerSetTx                  `protobuf:"bytes,25,opt,name=last_observed_signer_set,json=lastObservedSignerSet,proto3" json:"last_observed_signer_set,omitempty"`
+	EthereumHeightVotes              []*EthereumHeightVote         `protobuf:"bytes,26,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes,omitempty"`
+	CompletedOutgoingTxs             []*types.Any                  `protobuf:"bytes,27,rep,name=completed_outgoing_txs,json=completedOutgoingTxs,proto3" json:"completed_outgoing_txs,omitempty"`
+	LastUnbondingBlockHeight         uint64                        `protobuf:"varint,28,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	FormerKeyConfirmations           []*ValidatorConfirmation      `protobuf:"bytes,29,rep,name=former_key_confirmations,json=formerKeyConfirmations,proto3" json:"former_key_confirmations,omitempty"`
+	DelegateKeysRotationHeights      []*DelegateKeysRotationHeight `protobuf:"bytes,30,rep,name=delegate_keys_rotation_heights,json=delegateKeysRotationHeights,proto3" json:"delegate_keys_rotation_heights,omitempty"`
+	LastEthereumKeyRotationHeight    uint64                        `protobuf:"varint,31,opt,name=last_ethereum_key_rotation_height,json=lastEthereumKeyRotationHeight,proto3" json:"last_ethereum_key_rotation_height,omitempty"`
+	IbcForwards                      []*IBCForward                 `protobuf:"bytes,32,rep,name=ibc_forwards,json=ibcForwards,proto3" json:"ibc_forwards,omitempty"`
+	Erc20MetadataVotes               []*ERC20MetadataVote          `protobuf:"bytes,33,rep,name=erc20_metadata_votes,json=erc20MetadataVotes,proto3" json:"erc20_metadata_votes,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_387b0aba880adb60, []int{0}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -128,212 +85,217 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *GenesisState) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
-func (m *Params) GetGravityId() string {
+func (m *GenesisState) GetParams() *Params {
 	if m != nil {
-		return m.GravityId
+		return m.Params
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetContractSourceHash() string {
+func (m *GenesisState) GetLastObservedEventNonce() uint64 {
 	if m != nil {
-		return m.ContractSourceHash
+		return m.LastObservedEventNonce
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetBridgeEthereumAddress() string {
+func (m *GenesisState) GetOutgoingTxs() []*types.Any {
 	if m != nil {
-		return m.BridgeEthereumAddress
+		return m.OutgoingTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetBridgeChainId() uint64 {
+func (m *GenesisState) GetConfirmations() []*types.Any {
 	if m != nil {
-		return m.BridgeChainId
+		return m.Confirmations
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetSignedSignerSetTxsWindow() uint64 {
+func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
 	if m != nil {
-		return m.SignedSignerSetTxsWindow
+		return m.EthereumEventVoteRecords
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetSignedBatchesWindow() uint64 {
+func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
 	if m != nil {
-		return m.SignedBatchesWindow
+		return m.DelegateKeys
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetEthereumSignaturesWindow() uint64 {
+func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
 	if m != nil {
-		return m.EthereumSignaturesWindow
+		return m.Erc20ToDenoms
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetTargetEthTxTimeout() uint64 {
+func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
 	if m != nil {
-		return m.TargetEthTxTimeout
+		return m.UnbatchedSendToEthereumTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetAverageBlockTime() uint64 {
+func (m *GenesisState) GetErc20DeploymentRequests() []*ERC20DeploymentRequest {
 	if m != nil {
-		return m.AverageBlockTime
+		return m.Erc20DeploymentRequests
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetAverageEthereumBlockTime() uint64 {
+func (m *GenesisState) GetIbcDenomMetadata() []*IBCDenomMetadata {
 	if m != nil {
-		return m.AverageEthereumBlockTime
+		return m.IbcDenomMetadata
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetUnbondSlashingSignerSetTxsWindow() uint64 {
+func (m *GenesisState) GetBridgeMigration() *BridgeMigration {
 	if m != nil {
-		return m.UnbondSlashingSignerSetTxsWindow
+		return m.BridgeMigration
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetEthereumEventVoteWindow() uint64 {
+func (m *GenesisState) GetSignerSetHijackIncidents() []*SignerSetHijackIncident {
 	if m != nil {
-		return m.EthereumEventVoteWindow
+		return m.SignerSetHijackIncidents
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetConfirmedOutgoingTxWindow() uint64 {
+func (m *GenesisState) GetAdditionalOrchestrators() []*MsgAddOrchestrator {
 	if m != nil {
-		return m.ConfirmedOutgoingTxWindow
+		return m.AdditionalOrchestrators
 	}
-	return 0
+	return nil
 }
 
-func (*Params) XXX_MessageName() string {
-	return "gravity.v1.Params"
+func (m *GenesisState) GetAdditionalEvmChains() []*GenesisState {
+	if m != nil {
+		return m.AdditionalEvmChains
+	}
+	return nil
 }
 
-// GenesisState struct
-// TODO: this need to be audited and potentially simplified using the new
-// interfaces
-type GenesisState struct {
-	Params                     *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedEventNonce     uint64                     `protobuf:"varint,2,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
-	OutgoingTxs                []*types.Any               `protobuf:"bytes,3,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs,omitempty"`
-	Confirmations              []*types.Any               `protobuf:"bytes,4,rep,name=confirmations,proto3" json:"confirmations,omitempty"`
-	EthereumEventVoteRecords   []*EthereumEventVoteRecord `protobuf:"bytes,9,rep,name=ethereum_event_vote_records,json=ethereumEventVoteRecords,proto3" json:"ethereum_event_vote_records,omitempty"`
-	DelegateKeys               []*MsgDelegateKeys         `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys,omitempty"`
-	Erc20ToDenoms              []*ERC20ToDenom            `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms,omitempty"`
-	UnbatchedSendToEthereumTxs []*SendToEthereum          `protobuf:"bytes,12,rep,name=unbatched_send_to_ethereum_txs,json=unbatchedSendToEthereumTxs,proto3" json:"unbatched_send_to_ethereum_txs,omitempty"`
+func (m *GenesisState) GetLastEventNoncesByValidator() []*ValidatorEventNonce {
+	if m != nil {
+		return m.LastEventNoncesByValidator
+	}
+	return nil
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GenesisState) GetLatestSignerSetTxNonce() uint64 {
+	if m != nil {
+		return m.LatestSignerSetTxNonce
 	}
+	return 0
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
-}
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
+
+func (m *GenesisState) GetLastSlashedOutgoingTxBlockHeight() uint64 {
+	if m != nil {
+		return m.LastSlashedOutgoingTxBlockHeight
+	}
+	return 0
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+
+func (m *GenesisState) GetLastOutgoingBatchNonce() uint64 {
+	if m != nil {
+		return m.LastOutgoingBatchNonce
+	}
+	return 0
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+func (m *GenesisState) GetLastSendToEthereumId() uint64 {
+	if m != nil {
+		return m.LastSendToEthereumId
+	}
+	return 0
+}
 
-func (m *GenesisState) GetParams() *Params {
+func (m *GenesisState) GetLastObservedEthereumBlockHeight() *LatestEthereumBlockHeight {
 	if m != nil {
-		return m.Params
+		return m.LastObservedEthereumBlockHeight
 	}
 	return nil
 }
 
-func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+func (m *GenesisState) GetLastObservedSignerSet() *SignerSetTx {
 	if m != nil {
-		return m.LastObservedEventNonce
+		return m.LastObservedSignerSet
 	}
-	return 0
+	return nil
 }
 
-func (m *GenesisState) GetOutgoingTxs() []*types.Any {
+func (m *GenesisState) GetEthereumHeightVotes() []*EthereumHeightVote {
 	if m != nil {
-		return m.OutgoingTxs
+		return m.EthereumHeightVotes
 	}
 	return nil
 }
 
-func (m *GenesisState) GetConfirmations() []*types.Any {
+func (m *GenesisState) GetCompletedOutgoingTxs() []*types.Any {
 	if m != nil {
-		return m.Confirmations
+		return m.CompletedOutgoingTxs
 	}
 	return nil
 }
 
-func (m *GenesisState) GetEthereumEventVoteRecords() []*EthereumEventVoteRecord {
+func (m *GenesisState) GetLastUnbondingBlockHeight() uint64 {
 	if m != nil {
-		return m.EthereumEventVoteRecords
+		return m.LastUnbondingBlockHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFormerKeyConfirmations() []*ValidatorConfirmation {
+	if m != nil {
+		return m.FormerKeyConfirmations
 	}
 	return nil
 }
 
-func (m *GenesisState) GetDelegateKeys() []*MsgDelegateKeys {
+func (m *GenesisState) GetDelegateKeysRotationHeights() []*DelegateKeysRotationHeight {
 	if m != nil {
-		return m.DelegateKeys
+		return m.DelegateKeysRotationHeights
 	}
 	return nil
 }
 
-func (m *GenesisState) GetErc20ToDenoms() []*ERC20ToDenom {
+func (m *GenesisState) GetLastEthereumKeyRotationHeight() uint64 {
 	if m != nil {
-		return m.Erc20ToDenoms
+		return m.LastEthereumKeyRotationHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIbcForwards() []*IBCForward {
+	if m != nil {
+		return m.IbcForwards
 	}
 	return nil
 }
 
-func (m *GenesisState) GetUnbatchedSendToEthereumTxs() []*SendToEthereum {
+func (m *GenesisState) GetErc20MetadataVotes() []*ERC20MetadataVote {
 	if m != nil {
-		return m.UnbatchedSendToEthereumTxs
+		return m.Erc20MetadataVotes
 	}
 	return nil
 }
@@ -353,7 +315,7 @@ func (m *ERC20ToDenom) Reset()         { *m = ERC20ToDenom{} }
 func (m *ERC20ToDenom) String() string { return proto.CompactTextString(m) }
 func (*ERC20ToDenom) ProtoMessage()    {}
 func (*ERC20ToDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{2}
+	return fileDescriptor_387b0aba880adb60, []int{1}
 }
 func (m *ERC20ToDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,218 +361,400 @@ func (m *ERC20ToDenom) GetDenom() string {
 func (*ERC20ToDenom) XXX_MessageName() string {
 	return "gravity.v1.ERC20ToDenom"
 }
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+
+// ValidatorEventNonce records the nonce of the last Ethereum event a validator
+// voted on
+type ValidatorEventNonce struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	EventNonce       uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *ValidatorEventNonce) Reset()         { *m = ValidatorEventNonce{} }
+func (m *ValidatorEventNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEventNonce) ProtoMessage()    {}
+func (*ValidatorEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{2}
+}
+func (m *ValidatorEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEventNonce.Merge(m, src)
+}
+func (m *ValidatorEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEventNonce.DiscardUnknown(m)
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0x8e, 0x7f, 0x4d, 0xf3, 0x23, 0x63, 0x9b, 0x94, 0xa9, 0x43, 0x37, 0x4e, 0x70, 0x4d, 0x10,
-	0x55, 0x40, 0x64, 0x37, 0x31, 0x52, 0x11, 0x29, 0x7f, 0xda, 0x24, 0x06, 0x2a, 0x04, 0x41, 0x6b,
-	0x0b, 0x04, 0x17, 0x0c, 0xe3, 0x9d, 0x93, 0xf5, 0x12, 0x7b, 0x26, 0xda, 0x99, 0x75, 0xed, 0x3b,
-	0x1e, 0xa1, 0xcf, 0xc2, 0x13, 0x70, 0x99, 0xcb, 0x5e, 0x22, 0x84, 0x2a, 0x94, 0xbc, 0x08, 0x9a,
-	0x3f, 0x6b, 0xaf, 0xdd, 0x70, 0x93, 0xab, 0xf5, 0xcc, 0xf7, 0x7d, 0xe7, 0x7c, 0x73, 0x8e, 0xe7,
-	0x0c, 0xf2, 0xe2, 0x94, 0x8e, 0x12, 0x35, 0x09, 0x46, 0xfb, 0x41, 0x0c, 0x1c, 0x64, 0x22, 0xfd,
-	0xf3, 0x54, 0x28, 0x81, 0x91, 0x43, 0xfc, 0xd1, 0x7e, 0xbd, 0x16, 0x8b, 0x58, 0x98, 0xed, 0x40,
-	0xff, 0xb2, 0x8c, 0xfa, 0x9c, 0xd6, 0x91, 0x2d, 0xb2, 0x5e, 0x40, 0x86, 0x32, 0x76, 0x21, 0xeb,
-	0x1b, 0xb1, 0x10, 0xf1, 0x00, 0x02, 0xb3, 0xea, 0x65, 0xa7, 0x01, 0xe5, 0x4e, 0xb1, 0xfd, 0xc7,
-	0x2a, 0x5a, 0xf9, 0x8e, 0xa6, 0x74, 0x28, 0xf1, 0x5b, 0x28, 0x4f, 0x4d, 0x12, 0xe6, 0x95, 0x9a,
-	0xa5, 0x9d, 0xd5, 0x70, 0xd5, 0xed, 0x3c, 0x65, 0x78, 0x0f, 0xd5, 0x22, 0xc1, 0x55, 0x4a, 0x23,
-	0x45, 0xa4, 0xc8, 0xd2, 0x08, 0x48, 0x9f, 0xca, 0xbe, 0xf7, 0x3f, 0x43, 0xc4, 0x39, 0xd6, 0x31,
-	0xd0, 0x57, 0x54, 0xf6, 0xf1, 0x43, 0x74, 0xaf, 0x97, 0x26, 0x2c, 0x06, 0x02, 0xaa, 0x0f, 0x29,
-	0x64, 0x43, 0x42, 0x19, 0x4b, 0x41, 0x4a, 0x6f, 0xd9, 0x88, 0xd6, 0x2d, 0xdc, 0x76, 0xe8, 0x13,
-	0x0b, 0xe2, 0x07, 0x68, 0xcd, 0xe9, 0xa2, 0x3e, 0x4d, 0xb8, 0x76, 0x73, 0xbb, 0x59, 0xda, 0x59,
-	0x0e, 0xab, 0x76, 0xfb, 0x48, 0xef, 0x3e, 0x65, 0xf8, 0x33, 0xb4, 0x25, 0x93, 0x98, 0x03, 0x23,
-	0xe6, 0x93, 0x12, 0x09, 0x8a, 0xa8, 0xb1, 0x24, 0xcf, 0x12, 0xce, 0xc4, 0x33, 0x6f, 0xc5, 0x88,
-	0x3c, 0xcb, 0xe9, 0x18, 0x4a, 0x07, 0x54, 0x77, 0x2c, 0x7f, 0x30, 0x38, 0x6e, 0xa1, 0x75, 0xa7,
-	0xef, 0x51, 0x15, 0xf5, 0x61, 0x2a, 0xfc, 0xbf, 0x11, 0xde, 0xb5, 0xe0, 0xa1, 0xc5, 0x9c, 0xe6,
-	0x13, 0x54, 0x9f, 0x1e, 0x46, 0xe3, 0x54, 0x65, 0xe9, 0x4c, 0xf8, 0x9a, 0xcd, 0x98, 0x33, 0x3a,
-	0x53, 0x82, 0x53, 0xef, 0xa3, 0x75, 0x45, 0xd3, 0x18, 0x94, 0xae, 0x08, 0x51, 0x63, 0xa2, 0x92,
-	0x21, 0x88, 0x4c, 0x79, 0xc8, 0x08, 0xb1, 0x05, 0xdb, 0xaa, 0xdf, 0x1d, 0x77, 0x2d, 0x82, 0x3f,
-	0x40, 0x98, 0x8e, 0x20, 0xa5, 0x31, 0x90, 0xde, 0x40, 0x44, 0x67, 0x46, 0xe2, 0x95, 0x0d, 0xff,
-	0x8e, 0x43, 0x0e, 0x35, 0xa0, 0x05, 0xf8, 0x53, 0xb4, 0x99, 0xb3, 0xa7, 0x36, 0x0b, 0xb2, 0x8a,
-	0xf5, 0xe7, 0x28, 0x79, 0xdd, 0x67, 0x72, 0x8e, 0xb6, 0xe4, 0x80, 0xca, 0x3e, 0x39, 0xd5, 0xad,
-	0x4c, 0x04, 0x9f, 0xaf, 0xac, 0x57, 0x6d, 0x96, 0x76, 0x2a, 0x87, 0xfe, 0xc5, 0xcb, 0xfb, 0x4b,
-	0x7f, 0xbd, 0xbc, 0xff, 0x20, 0x4e, 0x54, 0x3f, 0xeb, 0xf9, 0x91, 0x18, 0x06, 0x91, 0x90, 0x43,
-	0x21, 0xdd, 0x67, 0x57, 0xb2, 0xb3, 0x40, 0x4d, 0xce, 0x41, 0xfa, 0xc7, 0x10, 0x85, 0x9e, 0x89,
-	0xf9, 0x85, 0x0b, 0x59, 0x68, 0x04, 0xfe, 0x05, 0xd5, 0x16, 0xf2, 0x99, 0x4e, 0x78, 0xaf, 0xdf,
-	0x28, 0x0f, 0x9e, 0xcb, 0x63, 0xfa, 0x86, 0x27, 0xe8, 0xed, 0x85, 0x0c, 0xaf, 0xb6, 0xcf, 0x5b,
-	0xbb, 0x51, 0xba, 0xc6, 0x5c, 0xba, 0xf6, 0x62, 0xcf, 0xf1, 0xf3, 0x12, 0xda, 0x5d, 0xc8, 0x1d,
-	0x09, 0x7e, 0x3a, 0x48, 0x22, 0x95, 0xf0, 0xf8, 0x3a, 0x1f, 0x77, 0x6e, 0xe4, 0xe3, 0xbd, 0x39,
-	0x1f, 0x47, 0xb3, 0x14, 0xaf, 0x5a, 0x3a, 0x41, 0xef, 0x66, 0xbc, 0x27, 0x38, 0x23, 0x46, 0xa3,
-	0x6d, 0x5c, 0x7f, 0x75, 0xde, 0x30, 0x7f, 0x94, 0xa6, 0x25, 0x77, 0x1c, 0xf7, 0x9a, 0x2b, 0xf4,
-	0xa8, 0x70, 0x1d, 0x60, 0x04, 0x5c, 0x91, 0x91, 0x50, 0x90, 0x47, 0xc1, 0x26, 0xca, 0xbd, 0x9c,
-	0xd1, 0xd6, 0x84, 0xef, 0x85, 0x02, 0x27, 0xfe, 0x1c, 0x6d, 0xe9, 0x82, 0x24, 0xe9, 0x10, 0x18,
-	0x11, 0x99, 0x8a, 0x85, 0x36, 0xa4, 0xc6, 0xb9, 0xfc, 0xae, 0x91, 0x6f, 0x4c, 0x39, 0x27, 0x8e,
-	0xd2, 0x1d, 0xdb, 0x00, 0x07, 0xcb, 0xbf, 0xfd, 0xdd, 0x5c, 0xda, 0xfe, 0x7d, 0x19, 0x55, 0xbe,
-	0xb4, 0x23, 0xb4, 0xa3, 0xa8, 0x02, 0xfc, 0x3e, 0x5a, 0x39, 0x37, 0x23, 0xcd, 0x0c, 0xb1, 0x72,
-	0x0b, 0xfb, 0xb3, 0x91, 0xea, 0xdb, 0x61, 0x17, 0x3a, 0x06, 0xfe, 0x18, 0x6d, 0x0c, 0xa8, 0x54,
-	0x44, 0xf4, 0x24, 0xa4, 0x23, 0x60, 0xee, 0x14, 0x5c, 0xf0, 0x08, 0xcc, 0x68, 0x5b, 0x0e, 0xdf,
-	0xd4, 0x84, 0x13, 0x87, 0x9b, 0x33, 0x7c, 0xab, 0x51, 0xfc, 0x11, 0xaa, 0x14, 0x4c, 0x4b, 0xef,
-	0x56, 0xf3, 0xd6, 0x4e, 0xb9, 0x55, 0xf3, 0xed, 0xb0, 0xf5, 0xf3, 0x61, 0xeb, 0x3f, 0xe1, 0x93,
-	0xb0, 0x2c, 0xa6, 0xde, 0x25, 0x3e, 0x40, 0x55, 0x77, 0x26, 0xaa, 0x3b, 0xa6, 0xa7, 0xe1, 0x7f,
-	0x2b, 0xe7, 0xa9, 0xb8, 0x87, 0x36, 0xaf, 0x2b, 0x78, 0x0a, 0x91, 0x48, 0x99, 0xf4, 0x56, 0x4d,
-	0xa4, 0x77, 0x8a, 0x07, 0x6e, 0x2f, 0x56, 0x3f, 0x34, 0xdc, 0xd9, 0x94, 0x5a, 0x00, 0x24, 0x7e,
-	0x8c, 0xaa, 0x0c, 0x06, 0x10, 0x53, 0x05, 0xe4, 0x0c, 0x26, 0xd2, 0x43, 0x26, 0xea, 0x66, 0x31,
-	0xea, 0x37, 0x32, 0x3e, 0x76, 0x9c, 0xaf, 0x61, 0x22, 0xc3, 0x0a, 0x2b, 0xac, 0xf0, 0x63, 0xb4,
-	0x06, 0x69, 0xd4, 0xda, 0x23, 0x4a, 0x10, 0x06, 0x5c, 0x0c, 0xa5, 0x57, 0x36, 0x31, 0xbc, 0x39,
-	0x67, 0xe1, 0x51, 0x6b, 0xaf, 0x2b, 0x8e, 0x35, 0x21, 0xac, 0x1a, 0x81, 0x5b, 0x49, 0xfc, 0x33,
-	0x6a, 0x64, 0xdc, 0x8e, 0x65, 0x46, 0x24, 0x70, 0xa6, 0x43, 0x4d, 0x4f, 0xae, 0xcb, 0x5d, 0x31,
-	0x01, 0xeb, 0xc5, 0x80, 0x1d, 0xe0, 0xac, 0x2b, 0xf2, 0x03, 0x87, 0xf5, 0x69, 0x84, 0x79, 0xa0,
-	0x3b, 0x96, 0xdb, 0x07, 0xa8, 0x52, 0x4c, 0x8f, 0x6b, 0xe8, 0xb6, 0x31, 0xe0, 0xde, 0x3d, 0xbb,
-	0xd0, 0xbb, 0xc6, 0xbe, 0x7b, 0xe4, 0xec, 0xe2, 0xf0, 0xc7, 0x8b, 0xcb, 0x46, 0xe9, 0xc5, 0x65,
-	0xa3, 0xf4, 0xcf, 0x65, 0xa3, 0xf4, 0xfc, 0xaa, 0xb1, 0x74, 0x71, 0xd5, 0x28, 0xbd, 0xb8, 0x6a,
-	0x2c, 0xfd, 0x79, 0xd5, 0x58, 0xfa, 0xe9, 0x51, 0xe1, 0xda, 0x9e, 0x43, 0x1c, 0x4f, 0x7e, 0x1d,
-	0xe5, 0xaf, 0xf4, 0xae, 0x7d, 0xbf, 0x82, 0xa1, 0x60, 0xd9, 0x00, 0x82, 0xd1, 0xc3, 0x60, 0x9c,
-	0x43, 0xf6, 0x3e, 0xf7, 0x56, 0x4c, 0xef, 0x3f, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x91,
-	0xa4, 0x3d, 0x1f, 0x08, 0x00, 0x00,
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorEventNonce proto.InternalMessageInfo
+
+func (m *ValidatorEventNonce) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorEventNonce) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConfirmedOutgoingTxWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ConfirmedOutgoingTxWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+func (*ValidatorEventNonce) XXX_MessageName() string {
+	return "gravity.v1.ValidatorEventNonce"
+}
+
+// EthereumHeightVote records the latest Ethereum height observed by a
+// validator
+type EthereumHeightVote struct {
+	ValidatorAddress string                    `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           LatestEthereumBlockHeight `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+}
+
+func (m *EthereumHeightVote) Reset()         { *m = EthereumHeightVote{} }
+func (m *EthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeightVote) ProtoMessage()    {}
+func (*EthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *EthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EthereumEventVoteWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumEventVoteWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+}
+func (m *EthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeightVote.Merge(m, src)
+}
+func (m *EthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeightVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeightVote proto.InternalMessageInfo
+
+func (m *EthereumHeightVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	return ""
+}
+
+func (m *EthereumHeightVote) GetHeight() LatestEthereumBlockHeight {
+	if m != nil {
+		return m.Height
 	}
-	{
-		size := m.SlashFractionConflictingEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return LatestEthereumBlockHeight{}
+}
+
+func (*EthereumHeightVote) XXX_MessageName() string {
+	return "gravity.v1.EthereumHeightVote"
+}
+
+// ERC20MetadataVote records the metadata of an Ethereum-originated token
+// reported by a validator, until the metadata of the token is registered
+type ERC20MetadataVote struct {
+	ValidatorAddress string        `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	TokenContract    string        `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Metadata         ERC20Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ERC20MetadataVote) Reset()         { *m = ERC20MetadataVote{} }
+func (m *ERC20MetadataVote) String() string { return proto.CompactTextString(m) }
+func (*ERC20MetadataVote) ProtoMessage()    {}
+func (*ERC20MetadataVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *ERC20MetadataVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20MetadataVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20MetadataVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.SlashFractionEthereumSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionEthereumSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionSignerSetTx.Size()
-		i -= size
-		if _, err := m.SlashFractionSignerSetTx.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *ERC20MetadataVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20MetadataVote.Merge(m, src)
+}
+func (m *ERC20MetadataVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20MetadataVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20MetadataVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20MetadataVote proto.InternalMessageInfo
+
+func (m *ERC20MetadataVote) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.TargetEthTxTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetEthTxTimeout))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *ERC20MetadataVote) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	if m.EthereumSignaturesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumSignaturesWindow))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *ERC20MetadataVote) GetMetadata() ERC20Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return ERC20Metadata{}
+}
+
+func (*ERC20MetadataVote) XXX_MessageName() string {
+	return "gravity.v1.ERC20MetadataVote"
+}
+
+// ValidatorConfirmation is a confirmation signed with an ethereum key the
+// validator has since rotated, which no longer resolves to the validator
+// through the delegate keys
+type ValidatorConfirmation struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Confirmation     *types.Any `protobuf:"bytes,2,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+}
+
+func (m *ValidatorConfirmation) Reset()         { *m = ValidatorConfirmation{} }
+func (m *ValidatorConfirmation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConfirmation) ProtoMessage()    {}
+func (*ValidatorConfirmation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *ValidatorConfirmation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConfirmation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConfirmation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedSignerSetTxsWindow))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *ValidatorConfirmation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConfirmation.Merge(m, src)
+}
+func (m *ValidatorConfirmation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConfirmation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConfirmation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorConfirmation proto.InternalMessageInfo
+
+func (m *ValidatorConfirmation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *ValidatorConfirmation) GetConfirmation() *types.Any {
+	if m != nil {
+		return m.Confirmation
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (*ValidatorConfirmation) XXX_MessageName() string {
+	return "gravity.v1.ValidatorConfirmation"
+}
+
+// DelegateKeysRotationHeight records the height at which a validator last
+// rotated its delegate keys
+type DelegateKeysRotationHeight struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DelegateKeysRotationHeight) Reset()         { *m = DelegateKeysRotationHeight{} }
+func (m *DelegateKeysRotationHeight) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysRotationHeight) ProtoMessage()    {}
+func (*DelegateKeysRotationHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{6}
+}
+func (m *DelegateKeysRotationHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysRotationHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysRotationHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DelegateKeysRotationHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysRotationHeight.Merge(m, src)
+}
+func (m *DelegateKeysRotationHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysRotationHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysRotationHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeysRotationHeight proto.InternalMessageInfo
+
+func (m *DelegateKeysRotationHeight) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *DelegateKeysRotationHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
+}
+
+func (*DelegateKeysRotationHeight) XXX_MessageName() string {
+	return "gravity.v1.DelegateKeysRotationHeight"
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*ValidatorEventNonce)(nil), "gravity.v1.ValidatorEventNonce")
+	proto.RegisterType((*EthereumHeightVote)(nil), "gravity.v1.EthereumHeightVote")
+	proto.RegisterType((*ERC20MetadataVote)(nil), "gravity.v1.ERC20MetadataVote")
+	proto.RegisterType((*ValidatorConfirmation)(nil), "gravity.v1.ValidatorConfirmation")
+	proto.RegisterType((*DelegateKeysRotationHeight)(nil), "gravity.v1.DelegateKeysRotationHeight")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 1266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0x13, 0xc7,
+	0x17, 0x8d, 0x21, 0x3f, 0xf4, 0x63, 0xe2, 0x40, 0x32, 0x71, 0x92, 0x89, 0x03, 0x4e, 0x30, 0xa2,
+	0x42, 0xad, 0x6a, 0x43, 0x2a, 0xd1, 0x02, 0xaa, 0x04, 0x36, 0x50, 0x28, 0xd0, 0xa0, 0x49, 0x8a,
+	0x44, 0x2b, 0xb1, 0xda, 0x3f, 0x37, 0xeb, 0x25, 0xde, 0x9d, 0x74, 0x67, 0xec, 0xc6, 0x2f, 0x7d,
+	0xec, 0x73, 0x3f, 0x44, 0x3f, 0x0c, 0x8f, 0x3c, 0xf6, 0x09, 0x55, 0xe4, 0x8b, 0x54, 0x73, 0x77,
+	0x76, 0x3d, 0x6b, 0x3b, 0x48, 0x79, 0xdb, 0x99, 0x7b, 0xee, 0x99, 0x33, 0x77, 0xe6, 0x9e, 0x59,
+	0xc2, 0xc2, 0xd4, 0x1d, 0x46, 0x6a, 0xd4, 0x1e, 0xde, 0x6e, 0x87, 0x90, 0x80, 0x8c, 0x64, 0xeb,
+	0x28, 0x15, 0x4a, 0x50, 0x62, 0x22, 0xad, 0xe1, 0xed, 0x7a, 0x2d, 0x14, 0xa1, 0xc0, 0xe9, 0xb6,
+	0xfe, 0xca, 0x10, 0xf5, 0x52, 0xae, 0x01, 0x67, 0x91, 0x55, 0x2b, 0x12, 0xcb, 0xd0, 0x50, 0xd6,
+	0x37, 0x42, 0x21, 0xc2, 0x3e, 0xb4, 0x71, 0xe4, 0x0d, 0x0e, 0xda, 0x6e, 0x62, 0x32, 0x9a, 0x1f,
+	0x97, 0x49, 0xf5, 0x87, 0x6c, 0xfd, 0x3d, 0xe5, 0x2a, 0xa0, 0x5f, 0x92, 0x0b, 0x47, 0x6e, 0xea,
+	0xc6, 0x92, 0x55, 0xb6, 0x2b, 0x37, 0x17, 0x76, 0x68, 0x6b, 0xac, 0xa7, 0xf5, 0x0a, 0x23, 0xdc,
+	0x20, 0xe8, 0x5d, 0xb2, 0xd1, 0x77, 0xa5, 0x72, 0x84, 0x27, 0x21, 0x1d, 0x42, 0xe0, 0xc0, 0x10,
+	0x12, 0xe5, 0x24, 0x22, 0xf1, 0x81, 0x9d, 0xdb, 0xae, 0xdc, 0x9c, 0xe7, 0x6b, 0x1a, 0xb0, 0x6b,
+	0xe2, 0x8f, 0x75, 0xf8, 0x27, 0x1d, 0xa5, 0xdf, 0x92, 0xaa, 0x18, 0xa8, 0x50, 0x44, 0x49, 0xe8,
+	0xa8, 0x63, 0xc9, 0xce, 0x6f, 0x9f, 0xbf, 0xb9, 0xb0, 0x53, 0x6b, 0x65, 0x4a, 0x5b, 0xb9, 0xd2,
+	0xd6, 0xc3, 0x64, 0xc4, 0x17, 0x72, 0xe4, 0xfe, 0xb1, 0xa4, 0xf7, 0xc8, 0xa2, 0x2f, 0x92, 0x83,
+	0x28, 0x8d, 0x5d, 0x15, 0x89, 0x44, 0xb2, 0xf9, 0xcf, 0x64, 0x96, 0xa1, 0xd4, 0x23, 0x9b, 0xa0,
+	0x7a, 0x90, 0xc2, 0x20, 0x36, 0x52, 0x87, 0x42, 0x81, 0x93, 0x82, 0x2f, 0xd2, 0x40, 0xb2, 0x8b,
+	0xc8, 0x74, 0xdd, 0xde, 0xf0, 0x63, 0x03, 0x47, 0xe5, 0xaf, 0x85, 0x02, 0x8e, 0x58, 0xce, 0x60,
+	0x76, 0x40, 0xd2, 0x07, 0x64, 0x31, 0x80, 0x3e, 0x84, 0xae, 0x02, 0xe7, 0x10, 0x46, 0x92, 0x11,
+	0x64, 0xdd, 0xb4, 0x59, 0x5f, 0xca, 0xf0, 0x91, 0xc1, 0x3c, 0x87, 0x91, 0xe4, 0xd5, 0xc0, 0x1a,
+	0xd1, 0x07, 0xe4, 0x32, 0xa4, 0xfe, 0xce, 0x2d, 0x47, 0x09, 0x27, 0x80, 0x44, 0xc4, 0x92, 0x2d,
+	0x20, 0x07, 0x2b, 0x29, 0xe3, 0xdd, 0x9d, 0x5b, 0xfb, 0xe2, 0x91, 0x06, 0xf0, 0x45, 0x4c, 0x30,
+	0x23, 0x49, 0xdf, 0x92, 0xc6, 0x20, 0xf1, 0x5c, 0xe5, 0xf7, 0x20, 0x70, 0x24, 0x24, 0x81, 0xa6,
+	0x2a, 0x76, 0xae, 0xcb, 0x5d, 0x45, 0xc2, 0xba, 0x4d, 0xb8, 0x07, 0x49, 0xb0, 0x2f, 0xf2, 0x0d,
+	0xf3, 0x7a, 0xc1, 0x50, 0x0e, 0xe8, 0x33, 0x78, 0x4b, 0x36, 0x32, 0x85, 0x01, 0x1c, 0xf5, 0xc5,
+	0x28, 0xd6, 0x95, 0x4c, 0xe1, 0xb7, 0x01, 0x48, 0x25, 0xd9, 0x22, 0x52, 0x37, 0xa7, 0xb4, 0x3e,
+	0x2a, 0xb0, 0x3c, 0x83, 0xf2, 0x75, 0x24, 0x99, 0x9a, 0x97, 0xf4, 0x47, 0x42, 0x23, 0xcf, 0xcf,
+	0x36, 0xef, 0xc4, 0xa0, 0xdc, 0xc0, 0x55, 0x2e, 0xbb, 0x84, 0xc4, 0x57, 0x6c, 0xe2, 0x67, 0x9d,
+	0x2e, 0x6e, 0xf9, 0xa5, 0xc1, 0xf0, 0xa5, 0xc8, 0xf3, 0x4b, 0x33, 0xf4, 0x09, 0x59, 0xf2, 0xd2,
+	0x28, 0x08, 0xc1, 0x89, 0xa3, 0x30, 0xc5, 0x8b, 0xc0, 0x2e, 0x6f, 0x57, 0x26, 0x8f, 0xa4, 0x83,
+	0x98, 0x97, 0x39, 0x84, 0x5f, 0xf6, 0xca, 0x13, 0xfa, 0xee, 0xc8, 0x28, 0x4c, 0x20, 0x75, 0x24,
+	0x28, 0xa7, 0x17, 0xbd, 0x73, 0xfd, 0x43, 0x27, 0x4a, 0xfc, 0x28, 0x80, 0x44, 0x49, 0xb6, 0x34,
+	0x7d, 0x77, 0xf6, 0x10, 0xbe, 0x07, 0xea, 0x29, 0x82, 0x9f, 0x19, 0x2c, 0x67, 0x72, 0x76, 0x40,
+	0xd2, 0x37, 0x84, 0xb9, 0x41, 0x10, 0xe9, 0xf5, 0xdc, 0xbe, 0x23, 0x52, 0xbf, 0x07, 0x52, 0xa5,
+	0xae, 0x12, 0xa9, 0x64, 0xcb, 0xb8, 0x40, 0x63, 0xe2, 0x1a, 0x3d, 0x0c, 0x82, 0x5d, 0x0b, 0xc6,
+	0xd7, 0xc7, 0xf9, 0xf6, 0xbc, 0xa4, 0x2f, 0xc8, 0xaa, 0x45, 0x0d, 0xc3, 0xd8, 0xf1, 0x7b, 0x6e,
+	0x94, 0x48, 0x46, 0xa7, 0xaf, 0x96, 0xed, 0x07, 0x7c, 0x65, 0x9c, 0xf6, 0x78, 0x18, 0x77, 0x31,
+	0x89, 0xfa, 0xa4, 0x81, 0x8d, 0x6f, 0xf5, 0xbb, 0x74, 0xbc, 0x91, 0x33, 0x74, 0xfb, 0x51, 0xa0,
+	0x17, 0x64, 0x2b, 0x48, 0xbb, 0x65, 0xd3, 0xbe, 0xce, 0x83, 0x63, 0x1b, 0xe0, 0x75, 0x4d, 0x33,
+	0x1e, 0xcb, 0xce, 0xa8, 0x40, 0xd1, 0x7b, 0xa4, 0xde, 0x77, 0x15, 0x48, 0xe5, 0x58, 0x85, 0x57,
+	0xc7, 0xc6, 0x5e, 0x6a, 0xb9, 0xbd, 0x68, 0x44, 0x51, 0xea, 0xfd, 0xe3, 0xcc, 0x5e, 0x76, 0xc9,
+	0x0d, 0x14, 0x28, 0xfb, 0xae, 0xd4, 0x4d, 0x60, 0x79, 0x8d, 0xe3, 0xf5, 0x85, 0x7f, 0xe8, 0xf4,
+	0x20, 0x0a, 0x7b, 0x8a, 0xad, 0x22, 0xcd, 0xb6, 0x06, 0xef, 0x65, 0xd8, 0xdd, 0xc2, 0x6c, 0x3a,
+	0x1a, 0xf8, 0x14, 0x71, 0x63, 0xab, 0xcb, 0x89, 0xb0, 0x39, 0x8c, 0x96, 0x35, 0xcb, 0xea, 0x4c,
+	0xbc, 0xa3, 0xc3, 0x99, 0x96, 0x3b, 0x84, 0x65, 0x5a, 0x26, 0x1b, 0x31, 0x0a, 0xd8, 0x3a, 0x66,
+	0xd6, 0x70, 0xf9, 0x52, 0x9b, 0x3d, 0x0b, 0xa8, 0x24, 0xd7, 0x27, 0xdc, 0x35, 0x4f, 0x2c, 0xed,
+	0x80, 0xe1, 0x65, 0xbe, 0x61, 0x57, 0xfa, 0x05, 0x16, 0x25, 0xa7, 0xb2, 0xb6, 0xc1, 0xb7, 0x4a,
+	0x76, 0x3c, 0x0d, 0xa0, 0xaf, 0x08, 0x2b, 0x2f, 0x3a, 0xae, 0x3d, 0xdb, 0xc0, 0x95, 0xd6, 0x67,
+	0xde, 0xf1, 0xfd, 0x63, 0xbe, 0x6a, 0x73, 0x17, 0x01, 0xca, 0xc9, 0x6a, 0x21, 0x3c, 0x93, 0x8c,
+	0xae, 0x2b, 0x59, 0x7d, 0xfa, 0x46, 0xe7, 0x8a, 0x32, 0x31, 0x68, 0xab, 0x2b, 0x30, 0x35, 0xa7,
+	0x0d, 0x62, 0xcd, 0x17, 0xf1, 0x51, 0x1f, 0x54, 0xf9, 0x6c, 0x25, 0xdb, 0xfc, 0xcc, 0x6b, 0x50,
+	0x2b, 0x72, 0x76, 0xad, 0x07, 0xe5, 0x7b, 0xb2, 0x89, 0x3b, 0x1e, 0x24, 0x9e, 0x48, 0x02, 0x3c,
+	0x5a, 0xbb, 0xbc, 0x57, 0xf0, 0x84, 0xb0, 0x28, 0x3f, 0xe7, 0x08, 0xbb, 0x60, 0xbf, 0x12, 0x76,
+	0x20, 0xd2, 0x18, 0x52, 0xed, 0xf6, 0x4e, 0xf9, 0x69, 0xba, 0x8a, 0x62, 0xae, 0xcd, 0x6c, 0x82,
+	0xae, 0x85, 0xe4, 0x6b, 0x19, 0xc5, 0x73, 0x18, 0x75, 0x4b, 0x0f, 0xd6, 0x21, 0x69, 0x94, 0x1e,
+	0x13, 0x27, 0x15, 0x0a, 0x43, 0x46, 0x9d, 0x64, 0x0d, 0x5c, 0xe2, 0x0b, 0x7b, 0x89, 0xd2, 0xd3,
+	0x62, 0xf0, 0xe6, 0xf8, 0x37, 0x83, 0x53, 0x63, 0x92, 0x3e, 0x25, 0xd7, 0xb2, 0xa6, 0xce, 0x4f,
+	0x4b, 0x6f, 0x68, 0x62, 0x41, 0xb6, 0x85, 0xe5, 0xb8, 0x8a, 0x6d, 0x6b, 0x70, 0xcf, 0x61, 0x54,
+	0xa6, 0xa2, 0x77, 0x49, 0x55, 0xfb, 0xf7, 0x81, 0x48, 0x7f, 0x77, 0xf5, 0xc3, 0xba, 0x8d, 0x22,
+	0xd7, 0x26, 0x9c, 0xfb, 0x49, 0x16, 0xe6, 0x0b, 0x91, 0xe7, 0x9b, 0x6f, 0x49, 0x77, 0x49, 0x2d,
+	0x7b, 0x5a, 0x72, 0xdb, 0x37, 0x97, 0xe5, 0x1a, 0x52, 0x5c, 0x9d, 0x7a, 0x55, 0x72, 0x9f, 0xc7,
+	0xbb, 0x42, 0x31, 0xd5, 0x9e, 0x92, 0xcd, 0x7b, 0xa4, 0x6a, 0x3f, 0x95, 0xb4, 0x46, 0xfe, 0x87,
+	0x28, 0xfc, 0xbd, 0xb9, 0xc8, 0xb3, 0x81, 0x9e, 0xc5, 0xd7, 0x06, 0xff, 0x5a, 0x2e, 0xf2, 0x6c,
+	0xd0, 0xf4, 0xc9, 0xca, 0x0c, 0xd3, 0xa2, 0x5f, 0x91, 0xe5, 0xc2, 0xe8, 0x1c, 0x37, 0x08, 0x52,
+	0x90, 0xd2, 0xd0, 0x2d, 0x15, 0x81, 0x87, 0xd9, 0x3c, 0xdd, 0x22, 0x0b, 0xd3, 0x7f, 0x45, 0x04,
+	0x0a, 0xb6, 0xe6, 0x9f, 0x15, 0x42, 0xa7, 0xef, 0xfd, 0xd9, 0x16, 0xe9, 0x92, 0x0b, 0xe6, 0x7c,
+	0xce, 0x9d, 0xc1, 0x0d, 0x3a, 0xf3, 0xef, 0x3f, 0x6e, 0xcd, 0x71, 0x93, 0xda, 0xfc, 0xbb, 0x42,
+	0x96, 0xa7, 0x6a, 0x7a, 0x36, 0x1d, 0x37, 0xc8, 0x25, 0x25, 0x0e, 0x21, 0xd1, 0x7d, 0xa0, 0x52,
+	0xd7, 0x57, 0xa6, 0x9e, 0x8b, 0x38, 0xdb, 0x35, 0x93, 0xf4, 0x3e, 0xf9, 0x7f, 0xf1, 0xaa, 0x9f,
+	0x47, 0xc1, 0x1b, 0xa7, 0x1e, 0xac, 0x11, 0x59, 0x24, 0x34, 0xff, 0x20, 0xab, 0x33, 0x9b, 0xe8,
+	0x6c, 0x4a, 0xbf, 0x23, 0x55, 0xbb, 0x57, 0x4d, 0xdd, 0x66, 0xfb, 0x46, 0x09, 0xd9, 0x74, 0x49,
+	0xfd, 0xf4, 0x0e, 0x3b, 0x9b, 0x88, 0xb5, 0xd2, 0xb1, 0xcd, 0xe7, 0x27, 0xd1, 0x79, 0xf3, 0xcb,
+	0xfd, 0x30, 0x52, 0xbd, 0x81, 0xd7, 0xf2, 0x45, 0xdc, 0x3e, 0x82, 0x30, 0x1c, 0xbd, 0x1b, 0xe6,
+	0xbf, 0xfa, 0x5f, 0x67, 0x7f, 0x26, 0xed, 0x58, 0x04, 0x83, 0x3e, 0xb4, 0x87, 0x77, 0xda, 0xc7,
+	0x79, 0xa8, 0xad, 0x46, 0x47, 0x20, 0xdf, 0x7f, 0x6a, 0x54, 0x3e, 0x7c, 0x6a, 0x54, 0xfe, 0xfd,
+	0xd4, 0xa8, 0xfc, 0x75, 0xd2, 0x98, 0x7b, 0x7f, 0xd2, 0xa8, 0x7c, 0x38, 0x69, 0xcc, 0xfd, 0x73,
+	0xd2, 0x98, 0xf3, 0x2e, 0xe0, 0xce, 0xbe, 0xf9, 0x6f, 0x00, 0x1c, 0x70, 0x0f, 0x4c, 0x80, 0x0c,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -633,10 +777,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Erc20MetadataVotes) > 0 {
+		for iNdEx := len(m.Erc20MetadataVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Erc20MetadataVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -644,13 +788,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IbcForwards) > 0 {
+		for iNdEx := len(m.IbcForwards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IbcForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -658,13 +804,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	if m.LastEthereumKeyRotationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEthereumKeyRotationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.DelegateKeysRotationHeights) > 0 {
+		for iNdEx := len(m.DelegateKeysRotationHeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DelegateKeysRotationHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -672,13 +827,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.EthereumEventVoteRecords) > 0 {
-		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FormerKeyConfirmations) > 0 {
+		for iNdEx := len(m.FormerKeyConfirmations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FormerKeyConfirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -686,13 +843,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.Confirmations) > 0 {
-		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.CompletedOutgoingTxs) > 0 {
+		for iNdEx := len(m.CompletedOutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CompletedOutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -700,13 +866,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -714,17 +882,28 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if m.LastObservedEventNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+	if m.LastObservedSignerSet != nil {
+		{
+			size, err := m.LastObservedSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if m.Params != nil {
+	if m.LastObservedEthereumBlockHeight != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastObservedEthereumBlockHeight.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -732,227 +911,1325 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.LastSendToEthereumId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSendToEthereumId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+	if m.LastOutgoingBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutgoingBatchNonce))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+	if m.LastSlashedOutgoingTxBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedOutgoingTxBlockHeight))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.LatestSignerSetTxNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestSignerSetTxNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for iNdEx := len(m.LastEventNoncesByValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNoncesByValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AdditionalEvmChains) > 0 {
+		for iNdEx := len(m.AdditionalEvmChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalEvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
-	}
-	if m.SignedSignerSetTxsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedSignerSetTxsWindow))
-	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
-	}
-	if m.EthereumSignaturesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.EthereumSignaturesWindow))
-	}
-	if m.TargetEthTxTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetEthTxTimeout))
-	}
-	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
-	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
-	}
-	l = m.SlashFractionSignerSetTx.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionEthereumSignature.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingEthereumSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingSignerSetTxsWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.UnbondSlashingSignerSetTxsWindow))
+	if len(m.AdditionalOrchestrators) > 0 {
+		for iNdEx := len(m.AdditionalOrchestrators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalOrchestrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if m.EthereumEventVoteWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.EthereumEventVoteWindow))
+	if len(m.SignerSetHijackIncidents) > 0 {
+		for iNdEx := len(m.SignerSetHijackIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSetHijackIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if m.ConfirmedOutgoingTxWindow != 0 {
-		n += 2 + sovGenesis(uint64(m.ConfirmedOutgoingTxWindow))
+	if m.BridgeMigration != nil {
+		{
+			size, err := m.BridgeMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.IbcDenomMetadata) > 0 {
+		for iNdEx := len(m.IbcDenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcDenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for iNdEx := len(m.Erc20DeploymentRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20DeploymentRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.LastObservedEventNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for iNdEx := len(m.UnbatchedSendToEthereumTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedSendToEthereumTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if len(m.OutgoingTxs) > 0 {
-		for _, e := range m.OutgoingTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.Confirmations) > 0 {
-		for _, e := range m.Confirmations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
 	}
 	if len(m.EthereumEventVoteRecords) > 0 {
-		for _, e := range m.EthereumEventVoteRecords {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.EthereumEventVoteRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumEventVoteRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Confirmations) > 0 {
+		for iNdEx := len(m.Confirmations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.UnbatchedSendToEthereumTxs) > 0 {
-		for _, e := range m.UnbatchedSendToEthereumTxs {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ValidatorEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ValidatorEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *ValidatorEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20MetadataVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20MetadataVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20MetadataVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConfirmation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConfirmation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConfirmation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Confirmation != nil {
+		{
+			size, err := m.Confirmation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegateKeysRotationHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysRotationHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysRotationHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Confirmations) > 0 {
+		for _, e := range m.Confirmations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+	}
+	if len(m.EthereumEventVoteRecords) > 0 {
+		for _, e := range m.EthereumEventVoteRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedSendToEthereumTxs) > 0 {
+		for _, e := range m.UnbatchedSendToEthereumTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20DeploymentRequests) > 0 {
+		for _, e := range m.Erc20DeploymentRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcDenomMetadata) > 0 {
+		for _, e := range m.IbcDenomMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeMigration != nil {
+		l = m.BridgeMigration.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SignerSetHijackIncidents) > 0 {
+		for _, e := range m.SignerSetHijackIncidents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdditionalOrchestrators) > 0 {
+		for _, e := range m.AdditionalOrchestrators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdditionalEvmChains) > 0 {
+		for _, e := range m.AdditionalEvmChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastEventNoncesByValidator) > 0 {
+		for _, e := range m.LastEventNoncesByValidator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LatestSignerSetTxNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LatestSignerSetTxNonce))
+	}
+	if m.LastSlashedOutgoingTxBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSlashedOutgoingTxBlockHeight))
+	}
+	if m.LastOutgoingBatchNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.LastOutgoingBatchNonce))
+	}
+	if m.LastSendToEthereumId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSendToEthereumId))
+	}
+	if m.LastObservedEthereumBlockHeight != nil {
+		l = m.LastObservedEthereumBlockHeight.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedSignerSet != nil {
+		l = m.LastObservedSignerSet.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompletedOutgoingTxs) > 0 {
+		for _, e := range m.CompletedOutgoingTxs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if len(m.FormerKeyConfirmations) > 0 {
+		for _, e := range m.FormerKeyConfirmations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeysRotationHeights) > 0 {
+		for _, e := range m.DelegateKeysRotationHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEthereumKeyRotationHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastEthereumKeyRotationHeight))
+	}
+	if len(m.IbcForwards) > 0 {
+		for _, e := range m.IbcForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20MetadataVotes) > 0 {
+		for _, e := range m.Erc20MetadataVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *EthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ERC20MetadataVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ValidatorConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Confirmation != nil {
+		l = m.Confirmation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysRotationHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmations = append(m.Confirmations, &types.Any{})
+			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
+			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
+			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20DeploymentRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20DeploymentRequests = append(m.Erc20DeploymentRequests, &ERC20DeploymentRequest{})
+			if err := m.Erc20DeploymentRequests[len(m.Erc20DeploymentRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenomMetadata = append(m.IbcDenomMetadata, &IBCDenomMetadata{})
+			if err := m.IbcDenomMetadata[len(m.IbcDenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BridgeMigration == nil {
+				m.BridgeMigration = &BridgeMigration{}
+			}
+			if err := m.BridgeMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSetHijackIncidents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerSetHijackIncidents = append(m.SignerSetHijackIncidents, &SignerSetHijackIncident{})
+			if err := m.SignerSetHijackIncidents[len(m.SignerSetHijackIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOrchestrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalOrchestrators = append(m.AdditionalOrchestrators, &MsgAddOrchestrator{})
+			if err := m.AdditionalOrchestrators[len(m.AdditionalOrchestrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalEvmChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalEvmChains = append(m.AdditionalEvmChains, &GenesisState{})
+			if err := m.AdditionalEvmChains[len(m.AdditionalEvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNoncesByValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNoncesByValidator = append(m.LastEventNoncesByValidator, &ValidatorEventNonce{})
+			if err := m.LastEventNoncesByValidator[len(m.LastEventNoncesByValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestSignerSetTxNonce", wireType)
+			}
+			m.LatestSignerSetTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestSignerSetTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedOutgoingTxBlockHeight", wireType)
+			}
+			m.LastSlashedOutgoingTxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashedOutgoingTxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutgoingBatchNonce", wireType)
+			}
+			m.LastOutgoingBatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -962,29 +2239,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastOutgoingBatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendToEthereumId", wireType)
 			}
-			var stringLen uint64
+			m.LastSendToEthereumId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -994,29 +2258,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastSendToEthereumId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumBlockHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1026,29 +2277,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			if m.LastObservedEthereumBlockHeight == nil {
+				m.LastObservedEthereumBlockHeight = &LatestEthereumBlockHeight{}
+			}
+			if err := m.LastObservedEthereumBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedSignerSet", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1058,16 +2313,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedSignerSetTxsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedSignerSet == nil {
+				m.LastObservedSignerSet = &SignerSetTx{}
+			}
+			if err := m.LastObservedSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SignedSignerSetTxsWindow = 0
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1077,16 +2349,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, &EthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedOutgoingTxs", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1096,16 +2383,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletedOutgoingTxs = append(m.CompletedOutgoingTxs, &types.Any{})
+			if err := m.CompletedOutgoingTxs[len(m.CompletedOutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSignaturesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
 			}
-			m.EthereumSignaturesWindow = 0
+			m.LastUnbondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1115,16 +2417,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumSignaturesWindow |= uint64(b&0x7F) << shift
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetEthTxTimeout", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormerKeyConfirmations", wireType)
 			}
-			m.TargetEthTxTimeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1134,16 +2436,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetEthTxTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FormerKeyConfirmations = append(m.FormerKeyConfirmations, &ValidatorConfirmation{})
+			if err := m.FormerKeyConfirmations[len(m.FormerKeyConfirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeysRotationHeights", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1153,16 +2470,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeysRotationHeights = append(m.DelegateKeysRotationHeights, &DelegateKeysRotationHeight{})
+			if err := m.DelegateKeysRotationHeights[len(m.DelegateKeysRotationHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEthereumKeyRotationHeight", wireType)
 			}
-			m.AverageEthereumBlockTime = 0
+			m.LastEthereumKeyRotationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1172,16 +2504,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				m.LastEthereumKeyRotationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionSignerSetTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcForwards", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1191,30 +2523,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionSignerSetTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcForwards = append(m.IbcForwards, &IBCForward{})
+			if err := m.IbcForwards[len(m.IbcForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20MetadataVotes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1224,30 +2557,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20MetadataVotes = append(m.Erc20MetadataVotes, &ERC20MetadataVote{})
+			if err := m.Erc20MetadataVotes[len(m.Erc20MetadataVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1257,30 +2641,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingEthereumSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1290,30 +2673,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingEthereumSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingSignerSetTxsWindow", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.UnbondSlashingSignerSetTxsWindow = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1323,35 +2755,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingSignerSetTxsWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteWindow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.EthereumEventVoteWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumEventVoteWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 19:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedOutgoingTxWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.ConfirmedOutgoingTxWindow = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1361,7 +2787,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfirmedOutgoingTxWindow |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1387,7 +2813,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *EthereumHeightVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1410,17 +2836,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthereumHeightVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1430,50 +2856,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
-			}
-			m.LastObservedEventNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1500,16 +2903,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutgoingTxs = append(m.OutgoingTxs, &types.Any{})
-			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20MetadataVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20MetadataVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20MetadataVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1519,31 +2971,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirmations = append(m.Confirmations, &types.Any{})
-			if err := m.Confirmations[len(m.Confirmations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumEventVoteRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1553,29 +3003,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumEventVoteRecords = append(m.EthereumEventVoteRecords, &EthereumEventVoteRecord{})
-			if err := m.EthereumEventVoteRecords[len(m.EthereumEventVoteRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1602,16 +3050,65 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, &MsgDelegateKeys{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConfirmation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConfirmation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1621,29 +3118,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, &ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedSendToEthereumTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1670,8 +3165,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedSendToEthereumTxs = append(m.UnbatchedSendToEthereumTxs, &SendToEthereum{})
-			if err := m.UnbatchedSendToEthereumTxs[len(m.UnbatchedSendToEthereumTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Confirmation == nil {
+				m.Confirmation = &types.Any{}
+			}
+			if err := m.Confirmation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1696,7 +3193,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *DelegateKeysRotationHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1719,15 +3216,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateKeysRotationHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateKeysRotationHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1755,13 +3252,13 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1771,24 +3268,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,13 @@
 package types
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,6 +27,142 @@ func TestGenesisStateValidate(t *testing.T) {
 				BridgeChainId:         3279089,
 			},
 		}, expErr: true},
+		"slash fraction above one": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.SlashFractionBatch = sdk.NewDec(2)
+				return p
+			}(),
+		}, expErr: true},
+		"negative slash fraction": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.SlashFractionSignerSetTx = sdk.NewDec(-1)
+				return p
+			}(),
+		}, expErr: true},
+		"signer set power diff threshold above one": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.SignerSetPowerDiffThreshold = sdk.NewDec(2)
+				return p
+			}(),
+		}, expErr: true},
+		"nil signer set unbonding power fraction": {src: &GenesisState{
+			Params: func() *Params {
+				p := DefaultParams()
+				p.SignerSetUnbondingPowerFraction = sdk.Dec{}
+				return p
+			}(),
+		}, expErr: true},
+		"valid signer set hijack incident": {src: &GenesisState{
+			Params: DefaultParams(),
+			SignerSetHijackIncidents: []*SignerSetHijackIncident{
+				{Height: 10, EventNonce: 2, SignerSetTxNonce: 1, ObservedSignersHash: "AA"},
+			},
+		}, expErr: false},
+		"signer set hijack incident with matching hashes": {src: &GenesisState{
+			Params: DefaultParams(),
+			SignerSetHijackIncidents: []*SignerSetHijackIncident{
+				{Height: 10, EventNonce: 2, SignerSetTxNonce: 1, ExpectedSignersHash: "AA", ObservedSignersHash: "AA"},
+			},
+		}, expErr: true},
+		"duplicate signer set hijack incidents": {src: &GenesisState{
+			Params: DefaultParams(),
+			SignerSetHijackIncidents: []*SignerSetHijackIncident{
+				{Height: 10, EventNonce: 2, SignerSetTxNonce: 1, ObservedSignersHash: "AA"},
+				{Height: 10, EventNonce: 2, SignerSetTxNonce: 1, ObservedSignersHash: "BB"},
+			},
+		}, expErr: true},
+		"valid additional evm chain": {src: &GenesisState{
+			Params:              DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{Params: evmChainParams(137, "gravity-polygon")}},
+		}, expErr: false},
+		"additional evm chain with chain id 0": {src: &GenesisState{
+			Params:              DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{Params: evmChainParams(0, "gravity-polygon")}},
+		}, expErr: true},
+		"additional evm chain with duplicate chain id": {src: &GenesisState{
+			Params: DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{
+				{Params: evmChainParams(137, "gravity-polygon")},
+				{Params: evmChainParams(137, "gravity-polygon-2")},
+			},
+		}, expErr: true},
+		"additional evm chain with duplicate gravity id": {src: &GenesisState{
+			Params:              DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{Params: evmChainParams(137, DefaultParams().GravityId)}},
+		}, expErr: true},
+		"additional evm chain with delegate keys": {src: &GenesisState{
+			Params: DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{
+				Params:       evmChainParams(137, "gravity-polygon"),
+				DelegateKeys: []*MsgDelegateKeys{{}},
+			}},
+		}, expErr: true},
+		"additional evm chain with an ethereum key rotation height": {src: &GenesisState{
+			Params: DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{
+				Params:                        evmChainParams(137, "gravity-polygon"),
+				LastEthereumKeyRotationHeight: 10,
+			}},
+		}, expErr: true},
+		"valid additional orchestrator": {src: &GenesisState{
+			Params: DefaultParams(),
+			DelegateKeys: []*MsgDelegateKeys{
+				{
+					ValidatorAddress:    "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z",
+					OrchestratorAddress: "cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf",
+					EthereumAddress:     "0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83",
+					EthSignature:        []byte("unused"),
+				},
+			},
+			AdditionalOrchestrators: []*MsgAddOrchestrator{
+				{ValidatorAddress: "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", OrchestratorAddress: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7"},
+			},
+		}, expErr: false},
+		"additional orchestrator without delegate keys": {src: &GenesisState{
+			Params: DefaultParams(),
+			AdditionalOrchestrators: []*MsgAddOrchestrator{
+				{ValidatorAddress: "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", OrchestratorAddress: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7"},
+			},
+		}, expErr: true},
+		"additional orchestrator reusing the delegate orchestrator": {src: &GenesisState{
+			Params: DefaultParams(),
+			DelegateKeys: []*MsgDelegateKeys{
+				{
+					ValidatorAddress:    "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z",
+					OrchestratorAddress: "cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf",
+					EthereumAddress:     "0xFDb0aaBD40774BBF3068Bf29E8b0a6C88BE26F83",
+					EthSignature:        []byte("unused"),
+				},
+			},
+			AdditionalOrchestrators: []*MsgAddOrchestrator{
+				{ValidatorAddress: "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z", OrchestratorAddress: "cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf"},
+			},
+		}, expErr: true},
+		"valid ibc forward": {src: &GenesisState{
+			Params:      DefaultParams(),
+			IbcForwards: []*IBCForward{{Receiver: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7", Channel: "channel-0"}},
+		}, expErr: false},
+		"invalid ibc forward channel": {src: &GenesisState{
+			Params:      DefaultParams(),
+			IbcForwards: []*IBCForward{{Receiver: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7", Channel: "0"}},
+		}, expErr: true},
+		"duplicate ibc forward": {src: &GenesisState{
+			Params: DefaultParams(),
+			IbcForwards: []*IBCForward{
+				{Receiver: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7", Channel: "channel-0"},
+				{Receiver: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7", Channel: "channel-0"},
+			},
+		}, expErr: true},
+		"additional evm chain with an ibc forward": {src: &GenesisState{
+			Params: DefaultParams(),
+			AdditionalEvmChains: []*GenesisState{{
+				Params:      evmChainParams(137, "gravity-polygon"),
+				IbcForwards: []*IBCForward{{Receiver: "cosmos193fw83ynn76328pty4yl7473vg9x86alq2cft7", Channel: "channel-0"}},
+			}},
+		}, expErr: true},
 		"valid delegate": {src: &GenesisState{
 			Params: DefaultParams(),
 			DelegateKeys: []*MsgDelegateKeys{
@@ -101,6 +242,236 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidateBridgeState(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	params := DefaultParams()
+	delegateKeys := []*MsgDelegateKeys{
+		{
+			ValidatorAddress:    "cosmosvaloper13yfm8as7y0mzsxqkfmk5jvgm45aez0u24jk95z",
+			OrchestratorAddress: "cosmos1h706wwrghfpydyh735aet8aluhf95dqj0psgyf",
+			EthereumAddress:     ethAddr.Hex(),
+			EthSignature:        []byte("unused"),
+		},
+	}
+
+	signerSet := NewSignerSetTx(1, 10, EthereumSigners{{Power: 100, EthereumAddress: ethAddr.Hex()}})
+	signerSetAny, err := PackOutgoingTx(signerSet)
+	require.NoError(t, err)
+
+	confirmation := func(key *ecdsa.PrivateKey) *cdctypes.Any {
+		signature, err := NewEthereumSignature(signerSet.GetCheckpoint([]byte(params.GravityId)), key)
+		require.NoError(t, err)
+		confa, err := PackConfirmation(&SignerSetTxConfirmation{
+			SignerSetNonce: signerSet.Nonce,
+			EthereumSigner: ethAddr.Hex(),
+			Signature:      signature,
+		})
+		require.NoError(t, err)
+		return confa
+	}
+
+	voteRecord := func(nonce uint64, accepted bool, receiver string) *EthereumEventVoteRecord {
+		event, err := PackEvent(&SendToCosmosEvent{
+			EventNonce:     nonce,
+			TokenContract:  ethAddr.Hex(),
+			Amount:         sdk.NewInt(10),
+			EthereumSender: ethAddr.Hex(),
+			CosmosReceiver: receiver,
+			EthereumHeight: nonce,
+		})
+		require.NoError(t, err)
+		return &EthereumEventVoteRecord{
+			Event:    event,
+			Votes:    []string{delegateKeys[0].ValidatorAddress},
+			Accepted: accepted,
+		}
+	}
+	receiver := delegateKeys[0].OrchestratorAddress
+	otherReceiver := "cosmos13yfm8as7y0mzsxqkfmk5jvgm45aez0u2sxzsc3"
+
+	badBatch, err := PackOutgoingTx(&BatchTx{BatchNonce: 1, TokenContract: "not-an-address"})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    *GenesisState
+		expErr bool
+	}{
+		"valid confirmation": {src: &GenesisState{
+			Params:        params,
+			DelegateKeys:  delegateKeys,
+			OutgoingTxs:   []*cdctypes.Any{signerSetAny},
+			Confirmations: []*cdctypes.Any{confirmation(privateKey)},
+		}, expErr: false},
+		"confirmation of a completed tx": {src: &GenesisState{
+			Params:               params,
+			DelegateKeys:         delegateKeys,
+			CompletedOutgoingTxs: []*cdctypes.Any{signerSetAny},
+			Confirmations:        []*cdctypes.Any{confirmation(privateKey)},
+		}, expErr: false},
+		"former key confirmation": {src: &GenesisState{
+			Params:       params,
+			OutgoingTxs:  []*cdctypes.Any{signerSetAny},
+			DelegateKeys: delegateKeys,
+			FormerKeyConfirmations: []*ValidatorConfirmation{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, Confirmation: confirmation(privateKey)},
+			},
+		}, expErr: false},
+		"former key confirmation of a validator without delegate keys": {src: &GenesisState{
+			Params:      params,
+			OutgoingTxs: []*cdctypes.Any{signerSetAny},
+			FormerKeyConfirmations: []*ValidatorConfirmation{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, Confirmation: confirmation(privateKey)},
+			},
+		}, expErr: true},
+		"tx signed twice by a validator": {src: &GenesisState{
+			Params:        params,
+			OutgoingTxs:   []*cdctypes.Any{signerSetAny},
+			DelegateKeys:  delegateKeys,
+			Confirmations: []*cdctypes.Any{confirmation(privateKey)},
+			FormerKeyConfirmations: []*ValidatorConfirmation{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, Confirmation: confirmation(privateKey)},
+			},
+		}, expErr: true},
+		"delegate keys rotation": {src: &GenesisState{
+			Params:                        params,
+			DelegateKeys:                  delegateKeys,
+			DelegateKeysRotationHeights:   []*DelegateKeysRotationHeight{{ValidatorAddress: delegateKeys[0].ValidatorAddress, Height: 10}},
+			LastEthereumKeyRotationHeight: 10,
+		}, expErr: false},
+		"delegate keys rotation of a validator without delegate keys": {src: &GenesisState{
+			Params:                      params,
+			DelegateKeysRotationHeights: []*DelegateKeysRotationHeight{{ValidatorAddress: delegateKeys[0].ValidatorAddress, Height: 10}},
+		}, expErr: true},
+		"ethereum key rotation after the last delegate keys rotation": {src: &GenesisState{
+			Params:                        params,
+			DelegateKeys:                  delegateKeys,
+			DelegateKeysRotationHeights:   []*DelegateKeysRotationHeight{{ValidatorAddress: delegateKeys[0].ValidatorAddress, Height: 10}},
+			LastEthereumKeyRotationHeight: 11,
+		}, expErr: true},
+		"tx both outgoing and completed": {src: &GenesisState{
+			Params:               params,
+			OutgoingTxs:          []*cdctypes.Any{signerSetAny},
+			CompletedOutgoingTxs: []*cdctypes.Any{signerSetAny},
+		}, expErr: true},
+		"duplicate last event nonce": {src: &GenesisState{
+			Params: params,
+			LastEventNoncesByValidator: []*ValidatorEventNonce{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, EventNonce: 1},
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, EventNonce: 2},
+			},
+		}, expErr: true},
+		"ethereum height vote of an invalid validator": {src: &GenesisState{
+			Params:              params,
+			EthereumHeightVotes: []*EthereumHeightVote{{ValidatorAddress: "invalid"}},
+		}, expErr: true},
+		"erc20 metadata votes": {src: &GenesisState{
+			Params: params,
+			Erc20MetadataVotes: []*ERC20MetadataVote{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Metadata: ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}},
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, TokenContract: "0xc783df8a850f42e7F7e57013759C285caa701eB6", Metadata: ERC20Metadata{Name: "Tether", Symbol: "USDT", Decimals: 6}},
+			},
+		}, expErr: false},
+		"duplicate erc20 metadata vote": {src: &GenesisState{
+			Params: params,
+			Erc20MetadataVotes: []*ERC20MetadataVote{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Metadata: ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}},
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, TokenContract: "0x429881672b9ae42b8eba0e26cd9c73711b891ca5", Metadata: ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 18}},
+			},
+		}, expErr: true},
+		"erc20 metadata vote with invalid decimals": {src: &GenesisState{
+			Params: params,
+			Erc20MetadataVotes: []*ERC20MetadataVote{
+				{ValidatorAddress: delegateKeys[0].ValidatorAddress, TokenContract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", Metadata: ERC20Metadata{Name: "USD Coin", Symbol: "USDC", Decimals: 256}},
+			},
+		}, expErr: true},
+		"invalid outgoing tx": {src: &GenesisState{
+			Params:      params,
+			OutgoingTxs: []*cdctypes.Any{badBatch},
+		}, expErr: true},
+		"duplicate outgoing tx": {src: &GenesisState{
+			Params:      params,
+			OutgoingTxs: []*cdctypes.Any{signerSetAny, signerSetAny},
+		}, expErr: true},
+		"confirmation of missing tx": {src: &GenesisState{
+			Params:        params,
+			DelegateKeys:  delegateKeys,
+			Confirmations: []*cdctypes.Any{confirmation(privateKey)},
+		}, expErr: true},
+		"confirmation without delegate keys": {src: &GenesisState{
+			Params:        params,
+			OutgoingTxs:   []*cdctypes.Any{signerSetAny},
+			Confirmations: []*cdctypes.Any{confirmation(privateKey)},
+		}, expErr: true},
+		"confirmation signed by another key": {src: &GenesisState{
+			Params:        params,
+			DelegateKeys:  delegateKeys,
+			OutgoingTxs:   []*cdctypes.Any{signerSetAny},
+			Confirmations: []*cdctypes.Any{confirmation(otherKey)},
+		}, expErr: true},
+		"accepted and pending vote records": {src: &GenesisState{
+			Params:                   params,
+			LastObservedEventNonce:   1,
+			EthereumEventVoteRecords: []*EthereumEventVoteRecord{voteRecord(1, true, receiver), voteRecord(2, false, receiver)},
+		}, expErr: false},
+		"pending vote record that lost to an accepted one": {src: &GenesisState{
+			Params:                   params,
+			LastObservedEventNonce:   1,
+			EthereumEventVoteRecords: []*EthereumEventVoteRecord{voteRecord(1, true, receiver), voteRecord(1, false, otherReceiver)},
+		}, expErr: false},
+		"pending vote record at the last observed nonce": {src: &GenesisState{
+			Params:                   params,
+			LastObservedEventNonce:   1,
+			EthereumEventVoteRecords: []*EthereumEventVoteRecord{voteRecord(1, false, receiver)},
+		}, expErr: true},
+		"accepted vote record above the last observed nonce": {src: &GenesisState{
+			Params:                   params,
+			LastObservedEventNonce:   1,
+			EthereumEventVoteRecords: []*EthereumEventVoteRecord{voteRecord(2, true, receiver)},
+		}, expErr: true},
+		"duplicate erc20 mapping": {src: &GenesisState{
+			Params: params,
+			Erc20ToDenoms: []*ERC20ToDenom{
+				{Erc20: ethAddr.Hex(), Denom: "ustake"},
+				{Erc20: ethAddr.Hex(), Denom: "uatom"},
+			},
+		}, expErr: true},
+		"duplicate denom mapping": {src: &GenesisState{
+			Params: params,
+			Erc20ToDenoms: []*ERC20ToDenom{
+				{Erc20: ethAddr.Hex(), Denom: "ustake"},
+				{Erc20: common.HexToAddress("0x01").Hex(), Denom: "ustake"},
+			},
+		}, expErr: true},
+		"bridge migration in progress": {src: &GenesisState{
+			Params:          params,
+			BridgeMigration: &BridgeMigration{NewBridgeEthereumAddress: ethAddr.Hex(), BridgeDeploymentHeight: 100, Height: 10},
+		}, expErr: false},
+		"bridge migration without deployment height": {src: &GenesisState{
+			Params:          params,
+			BridgeMigration: &BridgeMigration{NewBridgeEthereumAddress: ethAddr.Hex(), Height: 10},
+		}, expErr: true},
+		"bridge migration to an invalid address": {src: &GenesisState{
+			Params:          params,
+			BridgeMigration: &BridgeMigration{NewBridgeEthereumAddress: "invalid", BridgeDeploymentHeight: 100, Height: 10},
+		}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
@@ -122,3 +493,11 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+// evmChainParams returns the default params for an additional EVM chain
+func evmChainParams(chainID uint64, gravityID string) *Params {
+	p := DefaultParams()
+	p.BridgeChainId = chainID
+	p.GravityId = gravityID
+	return p
+}
@@ -2,7 +2,9 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-type GravityHooks interface {
+// EthereumEventHooks are notifications called after an observed Ethereum event
+// has been handled. They cannot fail.
+type EthereumEventHooks interface {
 	AfterContractCallExecutedEvent(ctx sdk.Context, event ContractCallExecutedEvent)
 	AfterERC20DeployedEvent(ctx sdk.Context, event ERC20DeployedEvent)
 	AfterSignerSetExecutedEvent(ctx sdk.Context, event SignerSetTxExecutedEvent)
@@ -10,9 +12,61 @@ type GravityHooks interface {
 	AfterSendToCosmosEvent(ctx sdk.Context, event SendToCosmosEvent)
 }
 
-type MultiGravityHooks []GravityHooks
+// BridgeFlowHooks are called around deposits and SendToEthereum transfers and
+// return an error to reject the flow. Every state change made by the flow,
+// including by other hooks, is then rolled back. For deposits this happens in
+// processEthereumEvent's cache context, so the event is still marked as
+// observed but has no effect on this chain. For SendToEthereum creation and
+// cancellation the error fails the enclosing tx.
+type BridgeFlowHooks interface {
+	// BeforeSendToCosmos is called before the coins of an observed deposit are
+	// minted or unlocked. The hook may change the event's CosmosReceiver to
+	// redirect the deposit. Changes to any other field reject the deposit.
+	BeforeSendToCosmos(ctx sdk.Context, event *SendToCosmosEvent) error
+	// BeforeSendToEthereum is called before the coins of a SendToEthereum are
+	// taken from the sender.
+	BeforeSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin) error
+	// AfterSendToEthereumCreated is called once a SendToEthereum has been added
+	// to the unbatched pool.
+	AfterSendToEthereumCreated(ctx sdk.Context, send SendToEthereum) error
+	// AfterSendToEthereumCancelled is called once a cancelled SendToEthereum has
+	// been removed from the pool and refunded.
+	AfterSendToEthereumCancelled(ctx sdk.Context, send SendToEthereum) error
+}
+
+// OutgoingTxHooks are notifications called as outgoing txs move through their
+// lifecycle. They cannot fail.
+type OutgoingTxHooks interface {
+	// AfterBatchCreated is called once a new batch has been stored
+	AfterBatchCreated(ctx sdk.Context, batch BatchTx)
+	// AfterBatchTimedOut is called once a batch that timed out on Ethereum has
+	// been cancelled and its transactions returned to the unbatched pool
+	AfterBatchTimedOut(ctx sdk.Context, batch BatchTx)
+	// AfterSignerSetTxCreated is called once a new signer set tx has been stored
+	AfterSignerSetTxCreated(ctx sdk.Context, signerSet SignerSetTx)
+	// AfterOutgoingTxCompleted is called once a batch, contract call or signer
+	// set tx has been observed as executed on Ethereum
+	AfterOutgoingTxCompleted(ctx sdk.Context, otx OutgoingTx)
+}
 
-func NewMultiGravityHooks(hooks ...GravityHooks) MultiGravityHooks {
+// GravityHooks is the full set of hooks called by the gravity keeper. Hooks
+// that only implement some of the hook interfaces, such as those written
+// against EthereumEventHooks alone, can be set by wrapping them in
+// MultiGravityHooks.
+type GravityHooks interface {
+	EthereumEventHooks
+	BridgeFlowHooks
+	OutgoingTxHooks
+}
+
+// MultiGravityHooks combines hooks into GravityHooks. Each element must
+// implement EthereumEventHooks and is called for the other hook interfaces
+// only if it implements them.
+type MultiGravityHooks []EthereumEventHooks
+
+var _ GravityHooks = MultiGravityHooks{}
+
+func NewMultiGravityHooks(hooks ...EthereumEventHooks) MultiGravityHooks {
 	return hooks
 }
 
@@ -45,3 +99,81 @@ func (mghs MultiGravityHooks) AfterSendToCosmosEvent(ctx sdk.Context, event Send
 		mghs[i].AfterSendToCosmosEvent(ctx, event)
 	}
 }
+
+// BeforeSendToCosmos calls each hook in order, stopping at the first error.
+// Each hook sees any redirect made by the hooks before it.
+func (mghs MultiGravityHooks) BeforeSendToCosmos(ctx sdk.Context, event *SendToCosmosEvent) error {
+	for i := range mghs {
+		if h, ok := mghs[i].(BridgeFlowHooks); ok {
+			if err := h.BeforeSendToCosmos(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) BeforeSendToEthereum(ctx sdk.Context, sender sdk.AccAddress, ethereumRecipient string, amount sdk.Coin, fee sdk.Coin) error {
+	for i := range mghs {
+		if h, ok := mghs[i].(BridgeFlowHooks); ok {
+			if err := h.BeforeSendToEthereum(ctx, sender, ethereumRecipient, amount, fee); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterSendToEthereumCreated(ctx sdk.Context, send SendToEthereum) error {
+	for i := range mghs {
+		if h, ok := mghs[i].(BridgeFlowHooks); ok {
+			if err := h.AfterSendToEthereumCreated(ctx, send); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterSendToEthereumCancelled(ctx sdk.Context, send SendToEthereum) error {
+	for i := range mghs {
+		if h, ok := mghs[i].(BridgeFlowHooks); ok {
+			if err := h.AfterSendToEthereumCancelled(ctx, send); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (mghs MultiGravityHooks) AfterBatchCreated(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		if h, ok := mghs[i].(OutgoingTxHooks); ok {
+			h.AfterBatchCreated(ctx, batch)
+		}
+	}
+}
+
+func (mghs MultiGravityHooks) AfterBatchTimedOut(ctx sdk.Context, batch BatchTx) {
+	for i := range mghs {
+		if h, ok := mghs[i].(OutgoingTxHooks); ok {
+			h.AfterBatchTimedOut(ctx, batch)
+		}
+	}
+}
+
+func (mghs MultiGravityHooks) AfterSignerSetTxCreated(ctx sdk.Context, signerSet SignerSetTx) {
+	for i := range mghs {
+		if h, ok := mghs[i].(OutgoingTxHooks); ok {
+			h.AfterSignerSetTxCreated(ctx, signerSet)
+		}
+	}
+}
+
+func (mghs MultiGravityHooks) AfterOutgoingTxCompleted(ctx sdk.Context, otx OutgoingTx) {
+	for i := range mghs {
+		if h, ok := mghs[i].(OutgoingTxHooks); ok {
+			h.AfterOutgoingTxCompleted(ctx, otx)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// IBCForwardTimeout is how long an IBC transfer created to forward a
+// SendToCosmosEvent has to be received on the destination chain before it
+// times out and the coins are refunded to the fallback address.
+const IBCForwardTimeout = 24 * time.Hour
+
+// IBCForwardAccount returns the account registered with the forward. The
+// account is derived from the forward itself, so it cannot be registered with
+// any other forward and every deposit to it is forwarded the same way.
+func IBCForwardAccount(forward IBCForward) sdk.AccAddress {
+	bz, err := forward.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return sdk.AccAddress(address.Module(ModuleName, append([]byte("ibc_forward/"), bz...))[:20])
+}
+
+// ValidateBasic performs stateless checks on the forward
+func (f IBCForward) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(f.Channel); err != nil {
+		return errors.Wrapf(ErrInvalid, "ibc forward channel: %s", err)
+	}
+	if _, _, err := bech32.DecodeAndConvert(f.Receiver); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc forward receiver %s", f.Receiver)
+	}
+	if len(f.Memo) > ibctransfertypes.MaximumMemoLength {
+		return errors.Wrapf(ErrInvalid, "ibc forward memo must not exceed %d bytes", ibctransfertypes.MaximumMemoLength)
+	}
+	if _, err := f.FallbackAddress(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FallbackAddress returns the local account that holds the coins when the
+// forward cannot be completed.
+func (f IBCForward) FallbackAddress() (sdk.AccAddress, error) {
+	if f.Fallback != "" {
+		addr, err := sdk.AccAddressFromBech32(f.Fallback)
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc forward fallback %s", f.Fallback)
+		}
+		return addr, nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(f.Receiver)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc forward receiver %s", f.Receiver)
+	}
+	addr := sdk.AccAddress(bz)
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "ibc forward fallback derived from %s", f.Receiver)
+	}
+	return addr, nil
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestIBCForwardValidateBasic(t *testing.T) {
+	var (
+		addrBytes                = bytes.Repeat([]byte{0x1}, 20)
+		localAddr sdk.AccAddress = addrBytes
+		otherAddr sdk.AccAddress = bytes.Repeat([]byte{0x2}, 20)
+	)
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", addrBytes)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		forward     types.IBCForward
+		expFallback sdk.AccAddress
+		expErr      bool
+	}{
+		"without fallback": {
+			forward:     types.IBCForward{Receiver: osmoAddr, Channel: "channel-0"},
+			expFallback: localAddr,
+		},
+		"with fallback and memo": {
+			forward:     types.IBCForward{Receiver: osmoAddr, Channel: "channel-7", Fallback: otherAddr.String(), Memo: "hello"},
+			expFallback: otherAddr,
+		},
+		"invalid channel": {
+			forward: types.IBCForward{Receiver: osmoAddr, Channel: "chan"},
+			expErr:  true,
+		},
+		"invalid receiver": {
+			forward: types.IBCForward{Receiver: "osmo1notanaddress", Channel: "channel-0"},
+			expErr:  true,
+		},
+		"invalid fallback": {
+			forward: types.IBCForward{Receiver: osmoAddr, Channel: "channel-0", Fallback: "cosmos1bad"},
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.forward.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			fallback, err := spec.forward.FallbackAddress()
+			require.NoError(t, err)
+			require.Equal(t, spec.expFallback, fallback)
+		})
+	}
+}
+
+func TestIBCForwardAccount(t *testing.T) {
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", bytes.Repeat([]byte{0x1}, 20))
+	require.NoError(t, err)
+
+	channel0 := types.IBCForward{Receiver: osmoAddr, Channel: "channel-0"}
+	channel1 := types.IBCForward{Receiver: osmoAddr, Channel: "channel-1"}
+	require.Len(t, types.IBCForwardAccount(channel0), 20)
+	require.Equal(t, types.IBCForwardAccount(channel0), types.IBCForwardAccount(channel0))
+	require.NotEqual(t, types.IBCForwardAccount(channel0), types.IBCForwardAccount(channel1))
+
+	// the account is a regular address, so deposits to it are valid events
+	event := &types.SendToCosmosEvent{
+		EventNonce:     1,
+		TokenContract:  "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		Amount:         sdk.NewInt(100),
+		EthereumSender: "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255",
+		CosmosReceiver: types.IBCForwardAccount(channel0).String(),
+	}
+	require.NoError(t, event.Validate())
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// IBCSendToEthereumMemoKey is the top level key of an ICS-20 memo that asks the
+// gravity IBC middleware to bridge the received coins on to Ethereum
+const IBCSendToEthereumMemoKey = "gravity"
+
+// IBCSendToEthereum is the body of an ICS-20 memo under the "gravity" key. The
+// received coins, less the bridge fee, are sent to the Ethereum recipient.
+// The bridge fee is an amount of the received denom and defaults to zero.
+//
+//	{"gravity":{"ethereum_recipient":"0x...","bridge_fee":"100"}}
+type IBCSendToEthereum struct {
+	EthereumRecipient string  `json:"ethereum_recipient"`
+	BridgeFee         sdk.Int `json:"bridge_fee"`
+}
+
+// ParseIBCSendToEthereumMemo parses an ICS-20 memo. It returns false if the
+// memo is not addressed to the gravity middleware, in which case the packet
+// should be handled as a regular transfer.
+func ParseIBCSendToEthereumMemo(memo string) (IBCSendToEthereum, bool, error) {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &envelope); err != nil {
+		return IBCSendToEthereum{}, false, nil
+	}
+
+	raw, ok := envelope[IBCSendToEthereumMemoKey]
+	if !ok {
+		return IBCSendToEthereum{}, false, nil
+	}
+
+	var send IBCSendToEthereum
+	if err := json.Unmarshal(raw, &send); err != nil {
+		return IBCSendToEthereum{}, true, errors.Wrapf(ErrInvalid, "gravity memo: %s", err)
+	}
+	if send.BridgeFee.IsNil() {
+		send.BridgeFee = sdk.ZeroInt()
+	}
+
+	return send, true, send.ValidateBasic()
+}
+
+// ValidateBasic performs stateless checks on the memo
+func (s IBCSendToEthereum) ValidateBasic() error {
+	if !common.IsHexAddress(s.EthereumRecipient) {
+		return errors.Wrapf(ErrInvalid, "gravity memo ethereum recipient %s", s.EthereumRecipient)
+	}
+	if s.BridgeFee.IsNil() || s.BridgeFee.IsNegative() {
+		return errors.Wrap(ErrInvalid, "gravity memo bridge fee must not be negative")
+	}
+	return nil
+}
+
+// IBCSendToEthereumAccount returns the intermediate account that receives the
+// coins of an ICS-20 packet before they are bridged to Ethereum. The account
+// is derived from the destination channel and the packet sender so that
+// senders on different chains never share an account.
+func IBCSendToEthereumAccount(channel, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, []byte(channel+"/"+sender))[:20])
+}
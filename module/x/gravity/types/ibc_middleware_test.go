@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/peggyjv/gravity-bridge/module/v6/x/gravity/types"
+)
+
+func TestParseIBCSendToEthereumMemo(t *testing.T) {
+	const recipient = "0x3c9289da00b02dC623d0D8D907619890301D26d4"
+
+	specs := map[string]struct {
+		memo       string
+		expSend    types.IBCSendToEthereum
+		expGravity bool
+		expErr     bool
+	}{
+		"empty memo": {},
+		"plain text memo": {
+			memo: "thanks for the tokens",
+		},
+		"other middleware memo": {
+			memo: `{"forward":{"receiver":"osmo1..."}}`,
+		},
+		"gravity memo": {
+			memo:       `{"gravity":{"ethereum_recipient":"` + recipient + `","bridge_fee":"10"}}`,
+			expSend:    types.IBCSendToEthereum{EthereumRecipient: recipient, BridgeFee: sdk.NewInt(10)},
+			expGravity: true,
+		},
+		"gravity memo without fee": {
+			memo:       `{"gravity":{"ethereum_recipient":"` + recipient + `"}}`,
+			expSend:    types.IBCSendToEthereum{EthereumRecipient: recipient, BridgeFee: sdk.ZeroInt()},
+			expGravity: true,
+		},
+		"invalid recipient": {
+			memo:       `{"gravity":{"ethereum_recipient":"0xnope","bridge_fee":"10"}}`,
+			expGravity: true,
+			expErr:     true,
+		},
+		"negative fee": {
+			memo:       `{"gravity":{"ethereum_recipient":"` + recipient + `","bridge_fee":"-1"}}`,
+			expGravity: true,
+			expErr:     true,
+		},
+		"malformed gravity memo": {
+			memo:       `{"gravity":"0x3c9289da00b02dC623d0D8D907619890301D26d4"}`,
+			expGravity: true,
+			expErr:     true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			send, ok, err := types.ParseIBCSendToEthereumMemo(spec.memo)
+			require.Equal(t, spec.expGravity, ok)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expGravity {
+				require.Equal(t, spec.expSend, send)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -65,8 +69,70 @@ const (
 
 	// CompletedOutgoingTxKey indexes the completed outgoing txs
 	CompletedOutgoingTxKey
+
+	// ERC20DeploymentRequestKey indexes the pending ERC20 deployment requests by denom
+	ERC20DeploymentRequestKey
+
+	// IBCDenomMetadataKey indexes the governance-registered ERC20 metadata of IBC denoms
+	IBCDenomMetadataKey
+
+	// BridgeMigrationKey indexes the bridge contract migration in progress, if any
+	BridgeMigrationKey
+
+	// ParamsKey indexes the gravity module parameters
+	ParamsKey
+
+	// SignerSetHijackIncidentKey indexes the signer set hijack incidents by height and event nonce
+	SignerSetHijackIncidentKey
+
+	// DelegateKeysRotationHeightKey indexes the height of the last delegate keys rotation by validator
+	DelegateKeysRotationHeightKey
+
+	// LastEthereumKeyRotationHeightKey indexes the height of the last ethereum key rotation of any validator
+	LastEthereumKeyRotationHeightKey
+
+	// ValidatorOrchestratorKey indexes the additional orchestrator accounts of each validator
+	ValidatorOrchestratorKey
+
+	// EVMChainKey indexes the registered EVM chains by chain id
+	EVMChainKey
+
+	// EVMChainStoreKey prefixes the state of each registered EVM chain by chain id
+	EVMChainStoreKey
+
+	// DefaultEVMChainIDKey indexes the id of the default EVM chain
+	DefaultEVMChainIDKey
+
+	// IBCForwardKey indexes the registered IBC forwards by account
+	IBCForwardKey
+
+	// ERC20MetadataVoteKey indexes the ERC20 metadata reported by each validator by token contract
+	ERC20MetadataVoteKey
 )
 
+// globalKeys are the store key prefixes of the state shared by all EVM chains,
+// every other prefix holds state of a single EVM chain
+var globalKeys = map[byte]bool{
+	ValidatorEthereumAddressKey:      true,
+	OrchestratorValidatorAddressKey:  true,
+	EthereumOrchestratorAddressKey:   true,
+	LastUnBondingBlockHeightKey:      true,
+	IBCDenomMetadataKey:              true,
+	DelegateKeysRotationHeightKey:    true,
+	LastEthereumKeyRotationHeightKey: true,
+	ValidatorOrchestratorKey:         true,
+	EVMChainKey:                      true,
+	EVMChainStoreKey:                 true,
+	DefaultEVMChainIDKey:             true,
+	IBCForwardKey:                    true,
+}
+
+// IsEVMChainKey returns true if the key holds state of a single EVM chain, in
+// which case it is stored under the EVMChainStoreKey prefix of that chain
+func IsEVMChainKey(key []byte) bool {
+	return len(key) > 0 && !globalKeys[key[0]]
+}
+
 ////////////////////
 // Key Delegation //
 ////////////////////
@@ -89,6 +155,54 @@ func MakeEthereumOrchestratorAddressKey(eth common.Address) []byte {
 	return append([]byte{EthereumOrchestratorAddressKey}, eth.Bytes()...)
 }
 
+// MakeValidatorOrchestratorKey returns the following key format
+// prefix   cosmos-validator                                  cosmos-orchestrator
+// [0x1d][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeValidatorOrchestratorKey(validator sdk.ValAddress, orc sdk.AccAddress) []byte {
+	return bytes.Join([][]byte{{ValidatorOrchestratorKey}, validator.Bytes(), orc.Bytes()}, []byte{})
+}
+
+//////////////////
+// IBC Forwards //
+//////////////////
+
+// MakeIBCForwardKey returns the following key format
+// prefix   ibc-forward-account
+// [0x21][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeIBCForwardKey(account sdk.AccAddress) []byte {
+	return append([]byte{IBCForwardKey}, account.Bytes()...)
+}
+
+//////////////////////////
+// ERC20 Metadata Votes //
+//////////////////////////
+
+// MakeERC20MetadataVoteKey returns the following key format
+// prefix   erc20                                        validator-address
+// [0x22][0xc783df8a850f42e7F7e57013759C285caa701eB6][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeERC20MetadataVoteKey(erc20 common.Address, validator sdk.ValAddress) []byte {
+	return bytes.Join([][]byte{{ERC20MetadataVoteKey}, erc20.Bytes(), validator.Bytes()}, []byte{})
+}
+
+////////////////
+// EVM Chains //
+////////////////
+
+// MakeEVMChainKey returns the following key format
+// prefix     evm-chain-id
+// [0x1e][0 0 0 0 0 0 0 1]
+func MakeEVMChainKey(evmChainID uint64) []byte {
+	return append([]byte{EVMChainKey}, sdk.Uint64ToBigEndian(evmChainID)...)
+}
+
+// MakeEVMChainStoreKey returns the prefix of the state of an EVM chain, which
+// is followed by the chain scoped keys
+// prefix     evm-chain-id
+// [0x1f][0 0 0 0 0 0 0 1]
+func MakeEVMChainStoreKey(evmChainID uint64) []byte {
+	return append([]byte{EVMChainStoreKey}, sdk.Uint64ToBigEndian(evmChainID)...)
+}
+
 /////////////////////////
 // Ethereum Signatures //
 /////////////////////////
@@ -137,6 +251,13 @@ func MakeSendToEthereumKey(id uint64, fee ERC20Token) []byte {
 	return bytes.Join([][]byte{{SendToEthereumKey}, common.HexToAddress(fee.Contract).Bytes(), fee.Amount.BigInt().FillBytes(amount), sdk.Uint64ToBigEndian(id)}, []byte{})
 }
 
+// MakeDelegateKeysRotationHeightKey returns the following key format
+// prefix              cosmos-validator
+// [0x1b][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func MakeDelegateKeysRotationHeightKey(validator sdk.ValAddress) []byte {
+	return append([]byte{DelegateKeysRotationHeightKey}, validator.Bytes()...)
+}
+
 // MakeLastEventNonceByValidatorKey indexes lateset event nonce by validator
 // MakeLastEventNonceByValidatorKey returns the following key format
 // prefix              cosmos-validator
@@ -153,6 +274,27 @@ func MakeERC20ToDenomKey(erc20 common.Address) []byte {
 	return append([]byte{ERC20ToDenomKey}, erc20.Bytes()...)
 }
 
+// MakeERC20DeploymentRequestKey returns the following key format
+// prefix  denom
+// [0x16][ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2]
+func MakeERC20DeploymentRequestKey(denom string) []byte {
+	return append([]byte{ERC20DeploymentRequestKey}, []byte(denom)...)
+}
+
+// MakeIBCDenomMetadataKey returns the following key format
+// prefix  denom
+// [0x17][ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2]
+func MakeIBCDenomMetadataKey(denom string) []byte {
+	return append([]byte{IBCDenomMetadataKey}, []byte(denom)...)
+}
+
+// MakeSignerSetHijackIncidentKey returns the following key format
+// prefix    height             event-nonce
+// [0x1a][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func MakeSignerSetHijackIncidentKey(height, eventNonce uint64) []byte {
+	return bytes.Join([][]byte{{SignerSetHijackIncidentKey}, sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(eventNonce)}, []byte{})
+}
+
 func MakeSignerSetTxKey(nonce uint64) []byte {
 	return append([]byte{SignerSetTxPrefixByte}, sdk.Uint64ToBigEndian(nonce)...)
 }
@@ -168,3 +310,187 @@ func MakeContractCallTxKey(invalscope []byte, invalnonce uint64) []byte {
 func MakeEthereumHeightVoteKey(validator sdk.ValAddress) []byte {
 	return append([]byte{EthereumHeightVoteKey}, validator.Bytes()...)
 }
+
+//////////////////
+// Key Decoding //
+//////////////////
+
+// validatorAddressLen is the length of the secp256k1 validator operator
+// addresses that trail the ethereum signature keys
+const validatorAddressLen = 20
+
+// storeKeyNames maps each store key prefix to a readable name
+var storeKeyNames = map[byte]string{
+	ValidatorEthereumAddressKey:      "ValidatorEthereumAddress",
+	OrchestratorValidatorAddressKey:  "OrchestratorValidatorAddress",
+	EthereumOrchestratorAddressKey:   "EthereumOrchestratorAddress",
+	EthereumSignatureKey:             "EthereumSignature",
+	EthereumEventVoteRecordKey:       "EthereumEventVoteRecord",
+	OutgoingTxKey:                    "OutgoingTx",
+	SendToEthereumKey:                "SendToEthereum",
+	LastEventNonceByValidatorKey:     "LastEventNonceByValidator",
+	LastObservedEventNonceKey:        "LastObservedEventNonce",
+	LatestSignerSetTxNonceKey:        "LatestSignerSetTxNonce",
+	LastSlashedOutgoingTxBlockKey:    "LastSlashedOutgoingTxBlock",
+	LastSlashedSignerSetTxNonceKey:   "LastSlashedSignerSetTxNonce",
+	LastOutgoingBatchNonceKey:        "LastOutgoingBatchNonce",
+	LastSendToEthereumIDKey:          "LastSendToEthereumID",
+	LastEthereumBlockHeightKey:       "LastEthereumBlockHeight",
+	DenomToERC20Key:                  "DenomToERC20",
+	ERC20ToDenomKey:                  "ERC20ToDenom",
+	LastUnBondingBlockHeightKey:      "LastUnBondingBlockHeight",
+	LastObservedSignerSetKey:         "LastObservedSignerSet",
+	EthereumHeightVoteKey:            "EthereumHeightVote",
+	CompletedOutgoingTxKey:           "CompletedOutgoingTx",
+	ERC20DeploymentRequestKey:        "ERC20DeploymentRequest",
+	IBCDenomMetadataKey:              "IBCDenomMetadata",
+	BridgeMigrationKey:               "BridgeMigration",
+	ParamsKey:                        "Params",
+	SignerSetHijackIncidentKey:       "SignerSetHijackIncident",
+	DelegateKeysRotationHeightKey:    "DelegateKeysRotationHeight",
+	LastEthereumKeyRotationHeightKey: "LastEthereumKeyRotationHeight",
+	ValidatorOrchestratorKey:         "ValidatorOrchestrator",
+	EVMChainKey:                      "EVMChain",
+	EVMChainStoreKey:                 "EVMChainStore",
+	DefaultEVMChainIDKey:             "DefaultEVMChainID",
+	IBCForwardKey:                    "IBCForward",
+	ERC20MetadataVoteKey:             "ERC20MetadataVote",
+}
+
+// DecodeStoreKey returns a readable form of a gravity store key, made of the
+// prefix name followed by the decoded key components, separated by slashes:
+// EthereumSignature/signer_set/1/cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn
+func DecodeStoreKey(key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("empty gravity store key")
+	}
+
+	name, ok := storeKeyNames[key[0]]
+	if !ok {
+		return "", fmt.Errorf("unknown gravity store key prefix 0x%X", key[0])
+	}
+
+	suffix := key[1:]
+	var parts []string
+	switch key[0] {
+	case ValidatorEthereumAddressKey, LastEventNonceByValidatorKey, EthereumHeightVoteKey, DelegateKeysRotationHeightKey:
+		parts = []string{sdk.ValAddress(suffix).String()}
+
+	case OrchestratorValidatorAddressKey, IBCForwardKey:
+		parts = []string{sdk.AccAddress(suffix).String()}
+
+	case EthereumOrchestratorAddressKey, ERC20ToDenomKey:
+		if len(suffix) != common.AddressLength {
+			return "", fmt.Errorf("%s key must hold an ethereum address, got %d bytes", name, len(suffix))
+		}
+		parts = []string{common.BytesToAddress(suffix).Hex()}
+
+	case EthereumSignatureKey:
+		// the validator address is the trailing part of the key, after the store index
+		if len(suffix) < validatorAddressLen {
+			return "", fmt.Errorf("%s key is too short: %d bytes", name, len(suffix))
+		}
+		storeIndex, validator := suffix[:len(suffix)-validatorAddressLen], suffix[len(suffix)-validatorAddressLen:]
+		index, err := decodeStoreIndex(storeIndex)
+		if err != nil {
+			return "", err
+		}
+		parts = append(index, sdk.ValAddress(validator).String())
+
+	case ERC20MetadataVoteKey:
+		// the token contract is followed by the validator address
+		if len(suffix) <= common.AddressLength {
+			return "", fmt.Errorf("%s key is too short: %d bytes", name, len(suffix))
+		}
+		parts = []string{common.BytesToAddress(suffix[:common.AddressLength]).Hex(), sdk.ValAddress(suffix[common.AddressLength:]).String()}
+
+	case ValidatorOrchestratorKey:
+		// the validator address is the leading part of the key, followed by the orchestrator address
+		if len(suffix) <= validatorAddressLen {
+			return "", fmt.Errorf("%s key is too short: %d bytes", name, len(suffix))
+		}
+		parts = []string{sdk.ValAddress(suffix[:validatorAddressLen]).String(), sdk.AccAddress(suffix[validatorAddressLen:]).String()}
+
+	case EthereumEventVoteRecordKey:
+		if len(suffix) < 8 {
+			return "", fmt.Errorf("%s key is too short: %d bytes", name, len(suffix))
+		}
+		parts = []string{strconv.FormatUint(sdk.BigEndianToUint64(suffix[:8]), 10), fmt.Sprintf("%X", suffix[8:])}
+
+	case OutgoingTxKey, CompletedOutgoingTxKey:
+		index, err := decodeStoreIndex(suffix)
+		if err != nil {
+			return "", err
+		}
+		parts = index
+
+	case SendToEthereumKey:
+		if len(suffix) != common.AddressLength+32+8 {
+			return "", fmt.Errorf("%s key must be %d bytes, got %d", name, common.AddressLength+32+8, len(suffix))
+		}
+		parts = []string{
+			common.BytesToAddress(suffix[:common.AddressLength]).Hex(),
+			new(big.Int).SetBytes(suffix[common.AddressLength : common.AddressLength+32]).String(),
+			strconv.FormatUint(sdk.BigEndianToUint64(suffix[common.AddressLength+32:]), 10),
+		}
+
+	case SignerSetHijackIncidentKey:
+		if len(suffix) != 16 {
+			return "", fmt.Errorf("%s key must be 16 bytes, got %d", name, len(suffix))
+		}
+		parts = []string{strconv.FormatUint(sdk.BigEndianToUint64(suffix[:8]), 10), strconv.FormatUint(sdk.BigEndianToUint64(suffix[8:]), 10)}
+
+	case DenomToERC20Key, ERC20DeploymentRequestKey, IBCDenomMetadataKey:
+		parts = []string{string(suffix)}
+
+	case EVMChainKey:
+		if len(suffix) != 8 {
+			return "", fmt.Errorf("%s key must be 8 bytes, got %d", name, len(suffix))
+		}
+		parts = []string{strconv.FormatUint(sdk.BigEndianToUint64(suffix), 10)}
+
+	case EVMChainStoreKey:
+		// the chain id is followed by a chain scoped key
+		if len(suffix) <= 8 || !IsEVMChainKey(suffix[8:]) {
+			return "", fmt.Errorf("%s key must hold an evm chain id and a chain scoped key", name)
+		}
+		inner, err := DecodeStoreKey(suffix[8:])
+		if err != nil {
+			return "", err
+		}
+		parts = []string{strconv.FormatUint(sdk.BigEndianToUint64(suffix[:8]), 10), inner}
+
+	default:
+		// singleton keys have no components
+		if len(suffix) != 0 {
+			return "", fmt.Errorf("%s key must not have a suffix, got %d bytes", name, len(suffix))
+		}
+	}
+
+	return strings.Join(append([]string{name}, parts...), "/"), nil
+}
+
+// decodeStoreIndex returns the readable components of an outgoing tx store index
+func decodeStoreIndex(storeIndex []byte) ([]string, error) {
+	if len(storeIndex) < 9 {
+		return nil, fmt.Errorf("outgoing tx store index is too short: %d bytes", len(storeIndex))
+	}
+
+	body, nonce := storeIndex[1:len(storeIndex)-8], strconv.FormatUint(sdk.BigEndianToUint64(storeIndex[len(storeIndex)-8:]), 10)
+	switch storeIndex[0] {
+	case SignerSetTxPrefixByte:
+		if len(body) != 0 {
+			return nil, fmt.Errorf("signer set tx store index must be 9 bytes, got %d", len(storeIndex))
+		}
+		return []string{"signer_set", nonce}, nil
+	case BatchTxPrefixByte:
+		if len(body) != common.AddressLength {
+			return nil, fmt.Errorf("batch tx store index must hold an ethereum address, got %d bytes", len(body))
+		}
+		return []string{"batch", common.BytesToAddress(body).Hex(), nonce}, nil
+	case ContractCallTxPrefixByte:
+		return []string{"contract_call", fmt.Sprintf("%X", body), nonce}, nil
+	default:
+		return nil, fmt.Errorf("unknown outgoing tx type 0x%X", storeIndex[0])
+	}
+}
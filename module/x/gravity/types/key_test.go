@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStoreKey(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromHex("F1169398014C5B8C5B5674A49FA51D3F71D4D23D")
+	require.NoError(t, err)
+	ethAddr := common.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	orchAddr, err := sdk.AccAddressFromHexUnsafe("B1D1FB8A5F1B0F30FD5C1C8B8DE2C12E83D8C6B4")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		key      []byte
+		expected string
+		err      bool
+	}{
+		{"validator ethereum address", MakeValidatorEthereumAddressKey(valAddr), "ValidatorEthereumAddress/" + valAddr.String(), false},
+		{"ethereum orchestrator address", MakeEthereumOrchestratorAddressKey(ethAddr), "EthereumOrchestratorAddress/" + ethAddr.Hex(), false},
+		{"signer set signature", MakeEthereumSignatureKey(MakeSignerSetTxKey(3), valAddr), "EthereumSignature/signer_set/3/" + valAddr.String(), false},
+		{"batch signature", MakeEthereumSignatureKey(MakeBatchTxKey(ethAddr, 7), valAddr), "EthereumSignature/batch/" + ethAddr.Hex() + "/7/" + valAddr.String(), false},
+		{"contract call", MakeOutgoingTxKey(MakeContractCallTxKey([]byte{0xab, 0xcd}, 2)), "OutgoingTx/contract_call/ABCD/2", false},
+		{"event vote record", MakeEthereumEventVoteRecordKey(5, []byte{0x01, 0x02}), "EthereumEventVoteRecord/5/0102", false},
+		{"send to ethereum", MakeSendToEthereumKey(9, NewERC20Token(100, ethAddr)), "SendToEthereum/" + ethAddr.Hex() + "/100/9", false},
+		{"denom to erc20", MakeDenomToERC20Key("ustake"), "DenomToERC20/ustake", false},
+		{"singleton", []byte{LastObservedEventNonceKey}, "LastObservedEventNonce", false},
+		{"bridge migration", []byte{BridgeMigrationKey}, "BridgeMigration", false},
+		{"params", []byte{ParamsKey}, "Params", false},
+		{"signer set hijack incident", MakeSignerSetHijackIncidentKey(120, 8), "SignerSetHijackIncident/120/8", false},
+		{"signer set hijack incident too short", []byte{SignerSetHijackIncidentKey, 0x01}, "", true},
+		{"delegate keys rotation height", MakeDelegateKeysRotationHeightKey(valAddr), "DelegateKeysRotationHeight/" + valAddr.String(), false},
+		{"last ethereum key rotation height", []byte{LastEthereumKeyRotationHeightKey}, "LastEthereumKeyRotationHeight", false},
+		{"validator orchestrator", MakeValidatorOrchestratorKey(valAddr, orchAddr), "ValidatorOrchestrator/" + valAddr.String() + "/" + orchAddr.String(), false},
+		{"validator orchestrator too short", MakeValidatorOrchestratorKey(valAddr, nil), "", true},
+		{"evm chain", MakeEVMChainKey(137), "EVMChain/137", false},
+		{"default evm chain id", []byte{DefaultEVMChainIDKey}, "DefaultEVMChainID", false},
+		{"ibc forward", MakeIBCForwardKey(orchAddr), "IBCForward/" + orchAddr.String(), false},
+		{"erc20 metadata vote", MakeERC20MetadataVoteKey(ethAddr, valAddr), "ERC20MetadataVote/" + ethAddr.Hex() + "/" + valAddr.String(), false},
+		{"erc20 metadata vote too short", MakeERC20MetadataVoteKey(ethAddr, nil), "", true},
+		{"evm chain store", append(MakeEVMChainStoreKey(137), MakeOutgoingTxKey(MakeSignerSetTxKey(3))...), "EVMChainStore/137/OutgoingTx/signer_set/3", false},
+		{"evm chain store global key", append(MakeEVMChainStoreKey(137), MakeValidatorEthereumAddressKey(valAddr)...), "", true},
+		{"evm chain store without key", MakeEVMChainStoreKey(137), "", true},
+		{"singleton with suffix", []byte{LastObservedEventNonceKey, 0x01}, "", true},
+		{"unknown prefix", []byte{0x99}, "", true},
+		{"empty", []byte{}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := DecodeStoreKey(tc.key)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, decoded)
+		})
+	}
+}
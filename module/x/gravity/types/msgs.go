@@ -17,6 +17,16 @@ var (
 	_ sdk.Msg = &MsgSubmitEthereumEvent{}
 	_ sdk.Msg = &MsgSubmitEthereumTxConfirmation{}
 	_ sdk.Msg = &MsgEthereumHeightVote{}
+	_ sdk.Msg = &MsgResyncEventNonce{}
+	_ sdk.Msg = &MsgRequestERC20Deployment{}
+	_ sdk.Msg = &MsgMigrateBridgeContract{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
+	_ sdk.Msg = &MsgAddOrchestrator{}
+	_ sdk.Msg = &MsgRemoveOrchestrator{}
+	_ sdk.Msg = &MsgAddEVMChain{}
+	_ sdk.Msg = &MsgRegisterIBCForward{}
+	_ sdk.Msg = &MsgERC20MetadataVote{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumEvent{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgSubmitEthereumTxConfirmation{}
@@ -53,6 +63,9 @@ func (msg MsgDelegateKeys) ValidateBasic() (err error) {
 	if len(msg.EthSignature) == 0 {
 		return ErrEmptyEthSig
 	}
+	if msg.SignatureVersion > DelegateKeysSignatureVersionEIP712 {
+		return errors.Wrapf(ErrInvalid, "unknown signature version %d", msg.SignatureVersion)
+	}
 
 	return nil
 }
@@ -290,3 +303,432 @@ func (msg MsgEthereumHeightVote) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{acc}
 }
+
+// NewMsgResyncEventNonce returns a new MsgResyncEventNonce
+func NewMsgResyncEventNonce(signer sdk.AccAddress) *MsgResyncEventNonce {
+	return &MsgResyncEventNonce{
+		Signer: signer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgResyncEventNonce) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgResyncEventNonce) Type() string { return "resync_event_nonce" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgResyncEventNonce) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResyncEventNonce) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResyncEventNonce) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRequestERC20Deployment returns a new MsgRequestERC20Deployment
+func NewMsgRequestERC20Deployment(signer sdk.AccAddress, denom string) *MsgRequestERC20Deployment {
+	return &MsgRequestERC20Deployment{
+		Signer: signer.String(),
+		Denom:  denom,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRequestERC20Deployment) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRequestERC20Deployment) Type() string { return "request_erc20_deployment" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRequestERC20Deployment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRequestERC20Deployment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRequestERC20Deployment) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgMigrateBridgeContract returns a new MsgMigrateBridgeContract
+func NewMsgMigrateBridgeContract(authority sdk.AccAddress, newBridgeEthereumAddress string, bridgeDeploymentHeight uint64) *MsgMigrateBridgeContract {
+	return &MsgMigrateBridgeContract{
+		Authority:                authority.String(),
+		NewBridgeEthereumAddress: newBridgeEthereumAddress,
+		BridgeDeploymentHeight:   bridgeDeploymentHeight,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMigrateBridgeContract) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMigrateBridgeContract) Type() string { return "migrate_bridge_contract" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgMigrateBridgeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+
+	migration := BridgeMigration{
+		NewBridgeEthereumAddress: msg.NewBridgeEthereumAddress,
+		BridgeDeploymentHeight:   msg.BridgeDeploymentHeight,
+	}
+	if err := migration.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMigrateBridgeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMigrateBridgeContract) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRotateDelegateKeys returns a new MsgRotateDelegateKeys
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, orchAddr sdk.AccAddress, ethAddr string, oldEthSig, newEthSig []byte) *MsgRotateDelegateKeys {
+	return &MsgRotateDelegateKeys{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+		EthereumAddress:     ethAddr,
+		OldEthSignature:     oldEthSig,
+		NewEthSignature:     newEthSig,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+	if !common.IsHexAddress(msg.EthereumAddress) {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "ethereum address")
+	}
+	if len(msg.OldEthSignature) == 0 || len(msg.NewEthSignature) == 0 {
+		return ErrEmptyEthSig
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgAddOrchestrator returns a new MsgAddOrchestrator
+func NewMsgAddOrchestrator(val sdk.ValAddress, orchAddr sdk.AccAddress) *MsgAddOrchestrator {
+	return &MsgAddOrchestrator{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddOrchestrator) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddOrchestrator) Type() string { return "add_orchestrator" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgAddOrchestrator) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddOrchestrator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddOrchestrator) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgRemoveOrchestrator returns a new MsgRemoveOrchestrator
+func NewMsgRemoveOrchestrator(val sdk.ValAddress, orchAddr sdk.AccAddress) *MsgRemoveOrchestrator {
+	return &MsgRemoveOrchestrator{
+		ValidatorAddress:    val.String(),
+		OrchestratorAddress: orchAddr.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRemoveOrchestrator) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveOrchestrator) Type() string { return "remove_orchestrator" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRemoveOrchestrator) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if _, err = sdk.AccAddressFromBech32(msg.OrchestratorAddress); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.OrchestratorAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveOrchestrator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveOrchestrator) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
+// NewMsgAddEVMChain returns a new MsgAddEVMChain
+func NewMsgAddEVMChain(authority sdk.AccAddress, params Params) *MsgAddEVMChain {
+	return &MsgAddEVMChain{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddEVMChain) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddEVMChain) Type() string { return "add_evm_chain" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgAddEVMChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+
+	// chain id 0 selects the default chain in messages and queries
+	if msg.Params.BridgeChainId == 0 {
+		return errors.Wrap(ErrInvalid, "evm chain id cannot be 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddEVMChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddEVMChain) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRegisterIBCForward returns a new MsgRegisterIBCForward
+func NewMsgRegisterIBCForward(signer sdk.AccAddress, forward IBCForward) *MsgRegisterIBCForward {
+	return &MsgRegisterIBCForward{
+		Signer:  signer.String(),
+		Forward: forward,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterIBCForward) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterIBCForward) Type() string { return "register_ibc_forward" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgRegisterIBCForward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	return msg.Forward.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterIBCForward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterIBCForward) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgERC20MetadataVote returns a new MsgERC20MetadataVote
+func NewMsgERC20MetadataVote(tokenContract common.Address, metadata ERC20Metadata, signer sdk.AccAddress) *MsgERC20MetadataVote {
+	return &MsgERC20MetadataVote{
+		Signer:        signer.String(),
+		TokenContract: tokenContract.Hex(),
+		Metadata:      metadata,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgERC20MetadataVote) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgERC20MetadataVote) Type() string { return "erc20_metadata_vote" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgERC20MetadataVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
+	}
+
+	if !common.IsHexAddress(msg.TokenContract) {
+		return errors.Wrap(ErrInvalid, "token contract")
+	}
+
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgERC20MetadataVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgERC20MetadataVote) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
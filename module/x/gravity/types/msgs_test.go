@@ -23,6 +23,7 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcSigVersion uint32
 		expErr        bool
 	}{
 		"all good": {
@@ -30,6 +31,19 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
 		},
+		"eip712 signature": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    ethAddress,
+			srcSigVersion: types.DelegateKeysSignatureVersionEIP712,
+		},
+		"unknown signature version": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    ethAddress,
+			srcSigVersion: 2,
+			expErr:        true,
+		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
 			srcCosmosAddr: cosmosAddress,
@@ -67,6 +81,7 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			msg := types.NewMsgDelegateKeys(spec.srcValAddr, spec.srcCosmosAddr, spec.srcETHAddr, []byte{0x1})
+			msg.SignatureVersion = spec.srcSigVersion
 			err := msg.ValidateBasic()
 			if spec.expErr {
 				assert.Error(t, err)
@@ -78,3 +93,50 @@ func TestValidateMsgDelegateKeys(t *testing.T) {
 	}
 
 }
+
+func TestValidateMsgMigrateBridgeContract(t *testing.T) {
+	var (
+		ethAddress                = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+		authority  sdk.AccAddress = bytes.Repeat([]byte{0x1}, app.MaxAddrLen)
+	)
+	specs := map[string]struct {
+		srcAuthority        sdk.AccAddress
+		srcETHAddr          string
+		srcDeploymentHeight uint64
+		expErr              bool
+	}{
+		"all good": {
+			srcAuthority:        authority,
+			srcETHAddr:          ethAddress,
+			srcDeploymentHeight: 100,
+		},
+		"empty authority": {
+			srcETHAddr:          ethAddress,
+			srcDeploymentHeight: 100,
+			expErr:              true,
+		},
+		"invalid eth address": {
+			srcAuthority:        authority,
+			srcETHAddr:          "invalid",
+			srcDeploymentHeight: 100,
+			expErr:              true,
+		},
+		"zero deployment height": {
+			srcAuthority: authority,
+			srcETHAddr:   ethAddress,
+			expErr:       true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			msg := types.NewMsgMigrateBridgeContract(spec.srcAuthority, spec.srcETHAddr, spec.srcDeploymentHeight)
+			err := msg.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
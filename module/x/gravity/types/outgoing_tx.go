@@ -1,10 +1,13 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 	"strings"
 
 	"cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -14,6 +17,9 @@ var (
 	_ OutgoingTx = &SignerSetTx{}
 	_ OutgoingTx = &BatchTx{}
 	_ OutgoingTx = &ContractCallTx{}
+
+	_ cdctypes.UnpackInterfacesMessage = &RelayableOutgoingTx{}
+	_ cdctypes.UnpackInterfacesMessage = &RelayableOutgoingTxsResponse{}
 )
 
 const (
@@ -23,6 +29,12 @@ const (
 	ContractCallTxPrefixByte
 )
 
+// ContractPowerThreshold is the power threshold the Gravity contract is
+// deployed with, 66% of the normalized signer set power of 2^32 (see
+// solidity/contract-deployer.ts). The contract only executes outgoing txs
+// whose signatures hold more power.
+const ContractPowerThreshold uint64 = 2834678415
+
 type ABIEncodedValsetArgs struct {
 	Validators   []gethcommon.Address `abi:"validators"`
 	Powers       []*big.Int           `abi:"powers"`
@@ -31,6 +43,22 @@ type ABIEncodedValsetArgs struct {
 	RewardToken  gethcommon.Address   `abi:"rewardToken"`
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *RelayableOutgoingTx) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var otx OutgoingTx
+	return unpacker.UnpackAny(m.OutgoingTx, &otx)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *RelayableOutgoingTxsResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for i := range m.Txs {
+		if err := m.Txs[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 ///////////////////
 // GetStoreIndex //
 ///////////////////
@@ -48,6 +76,89 @@ func (cctx *ContractCallTx) GetStoreIndex() []byte {
 	return MakeContractCallTxKey(cctx.InvalidationScope, cctx.InvalidationNonce)
 }
 
+//////////////
+// Validate //
+//////////////
+
+func (sstx *SignerSetTx) Validate() error {
+	if sstx.Nonce == 0 {
+		return fmt.Errorf("nonce must be set")
+	}
+	seen := make(map[gethcommon.Address]bool, len(sstx.Signers))
+	for i, signer := range sstx.Signers {
+		if err := signer.ValidateBasic(); err != nil {
+			return fmt.Errorf("ethereum signer %d error: %w", i, err)
+		}
+		addr := gethcommon.HexToAddress(signer.EthereumAddress)
+		if seen[addr] {
+			return fmt.Errorf("duplicate ethereum signer %s", addr.Hex())
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func (btx *BatchTx) Validate() error {
+	if btx.BatchNonce == 0 {
+		return fmt.Errorf("nonce must be set")
+	}
+	if !gethcommon.IsHexAddress(btx.TokenContract) {
+		return fmt.Errorf("token contract address must be valid ethereum address")
+	}
+	for i, ste := range btx.Transactions {
+		if err := ste.Validate(); err != nil {
+			return fmt.Errorf("send to ethereum %d error: %w", i, err)
+		}
+		if gethcommon.HexToAddress(ste.Erc20Token.Contract) != gethcommon.HexToAddress(btx.TokenContract) {
+			return fmt.Errorf("send to ethereum %d token contract %s does not match batch token contract %s", ste.Id, ste.Erc20Token.Contract, btx.TokenContract)
+		}
+	}
+	return nil
+}
+
+func (cctx *ContractCallTx) Validate() error {
+	if cctx.InvalidationNonce == 0 {
+		return fmt.Errorf("invalidation nonce must be set")
+	}
+	if len(cctx.InvalidationScope) == 0 || len(cctx.InvalidationScope) > 32 {
+		return fmt.Errorf("invalidation scope must be between 1 and 32 bytes")
+	}
+	if !gethcommon.IsHexAddress(cctx.Address) {
+		return fmt.Errorf("contract address must be valid ethereum address")
+	}
+	for i, token := range cctx.Tokens {
+		if err := token.Validate(); err != nil {
+			return fmt.Errorf("token %d error: %w", i, err)
+		}
+	}
+	for i, fee := range cctx.Fees {
+		if err := fee.Validate(); err != nil {
+			return fmt.Errorf("fee %d error: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a send to ethereum
+func (ste *SendToEthereum) Validate() error {
+	if ste.Id == 0 {
+		return fmt.Errorf("id must be set")
+	}
+	if _, err := sdk.AccAddressFromBech32(ste.Sender); err != nil {
+		return fmt.Errorf("invalid sender %s: %w", ste.Sender, err)
+	}
+	if !gethcommon.IsHexAddress(ste.EthereumRecipient) {
+		return fmt.Errorf("ethereum recipient must be valid ethereum address")
+	}
+	if err := ste.Erc20Token.Validate(); err != nil {
+		return err
+	}
+	if err := ste.Erc20Fee.Validate(); err != nil {
+		return fmt.Errorf("fee: %w", err)
+	}
+	return nil
+}
+
 ///////////////////
 // GetCheckpoint //
 ///////////////////
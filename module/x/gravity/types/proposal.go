@@ -4,21 +4,35 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
 	// ProposalTypeCommunityPoolEthereumSpend defines the type for a CommunityPoolEthereumSpendProposal
 	ProposalTypeCommunityPoolEthereumSpend = "CommunityPoolEthereumSpend"
+	// ProposalTypeERC20Metadata defines the type for an ERC20MetadataProposal
+	ProposalTypeERC20Metadata = "ERC20Metadata"
+	// ProposalTypeIBCDenomMetadata defines the type for an IBCDenomMetadataProposal
+	ProposalTypeIBCDenomMetadata = "IBCDenomMetadata"
 )
 
 // Assert CommunityPoolEthereumSpendProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &CommunityPoolEthereumSpendProposal{}
 
+// Assert ERC20MetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ERC20MetadataProposal{}
+
+// Assert IBCDenomMetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &IBCDenomMetadataProposal{}
+
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolEthereumSpend)
+	govtypes.RegisterProposalType(ProposalTypeERC20Metadata)
+	govtypes.RegisterProposalType(ProposalTypeIBCDenomMetadata)
 }
 
 // NewCommunityPoolEthereumSpendProposal creates a new community pool spend proposal.
@@ -80,3 +94,115 @@ func (csp CommunityPoolEthereumSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.BridgeFee))
 	return b.String()
 }
+
+// NewERC20MetadataProposal creates a new ERC20 metadata proposal.
+func NewERC20MetadataProposal(title, description, tokenContract string, metadata ERC20Metadata) *ERC20MetadataProposal {
+	return &ERC20MetadataProposal{title, description, tokenContract, metadata}
+}
+
+// GetTitle returns the title of an ERC20 metadata proposal.
+func (emp *ERC20MetadataProposal) GetTitle() string { return emp.Title }
+
+// GetDescription returns the description of an ERC20 metadata proposal.
+func (emp *ERC20MetadataProposal) GetDescription() string { return emp.Description }
+
+// ProposalRoute returns the routing key of an ERC20 metadata proposal.
+func (emp *ERC20MetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an ERC20 metadata proposal.
+func (emp *ERC20MetadataProposal) ProposalType() string { return ProposalTypeERC20Metadata }
+
+// ValidateBasic runs basic stateless validity checks, including that the
+// resulting bank metadata is valid
+func (emp *ERC20MetadataProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(emp)
+	if err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(emp.TokenContract) {
+		return errors.Wrap(ErrInvalidERC20MetadataProposal, "token contract")
+	}
+
+	if err := emp.Metadata.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalidERC20MetadataProposal, err.Error())
+	}
+
+	denom := GravityDenom(common.HexToAddress(emp.TokenContract))
+	if err := emp.Metadata.BankMetadata(denom).Validate(); err != nil {
+		return errors.Wrap(ErrInvalidERC20MetadataProposal, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (emp ERC20MetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`ERC20 Metadata Proposal:
+  Title:          %s
+  Description:    %s
+  Token Contract: %s
+  Name:           %s
+  Symbol:         %s
+  Decimals:       %d
+`, emp.Title, emp.Description, emp.TokenContract, emp.Metadata.Name, emp.Metadata.Symbol, emp.Metadata.Decimals))
+	return b.String()
+}
+
+// NewIBCDenomMetadataProposal creates a new IBC denom metadata proposal.
+func NewIBCDenomMetadataProposal(title, description, denom string, metadata ERC20Metadata) *IBCDenomMetadataProposal {
+	return &IBCDenomMetadataProposal{title, description, denom, metadata}
+}
+
+// GetTitle returns the title of an IBC denom metadata proposal.
+func (idmp *IBCDenomMetadataProposal) GetTitle() string { return idmp.Title }
+
+// GetDescription returns the description of an IBC denom metadata proposal.
+func (idmp *IBCDenomMetadataProposal) GetDescription() string { return idmp.Description }
+
+// ProposalRoute returns the routing key of an IBC denom metadata proposal.
+func (idmp *IBCDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an IBC denom metadata proposal.
+func (idmp *IBCDenomMetadataProposal) ProposalType() string { return ProposalTypeIBCDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (idmp *IBCDenomMetadataProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(idmp)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(idmp.Denom, ibctransfertypes.DenomPrefix+"/") {
+		return errors.Wrapf(ErrInvalidIBCDenomMetadataProposal, "%s is not an IBC denom", idmp.Denom)
+	}
+
+	if err := ibctransfertypes.ValidateIBCDenom(idmp.Denom); err != nil {
+		return errors.Wrap(ErrInvalidIBCDenomMetadataProposal, err.Error())
+	}
+
+	if idmp.Metadata.Name == "" || idmp.Metadata.Symbol == "" {
+		return errors.Wrap(ErrInvalidIBCDenomMetadataProposal, "ERC20 name and symbol cannot be blank")
+	}
+
+	if err := idmp.Metadata.ValidateBasic(); err != nil {
+		return errors.Wrap(ErrInvalidIBCDenomMetadataProposal, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (idmp IBCDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`IBC Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Name:        %s
+  Symbol:      %s
+  Decimals:    %d
+`, idmp.Title, idmp.Description, idmp.Denom, idmp.Metadata.Name, idmp.Metadata.Symbol, idmp.Metadata.Decimals))
+	return b.String()
+}
@@ -48,6 +48,19 @@ func (b EthereumSigners) Hash() []byte {
 	return hash[:]
 }
 
+// Reasons for creating a new signer set tx, as reported by the SignerSetDrift query
+const (
+	SignerSetReasonNoSignerSet = "no_signer_set"
+	SignerSetReasonUnbonding   = "validator_unbonding"
+	SignerSetReasonPowerDiff   = "power_diff"
+	SignerSetReasonMaxAge      = "max_age"
+	SignerSetReasonKeyRotation = "delegate_keys_rotation"
+)
+
+// MaxAdditionalOrchestrators is the number of orchestrator accounts a validator
+// can register with MsgAddOrchestrator, on top of its delegate keys
+const MaxAdditionalOrchestrators = 4
+
 // PowerDiff returns the difference in power between two bridge validator sets
 // note this is Gravity bridge power *not* Cosmos voting power. Cosmos voting
 // power is based on the absolute number of tokens in the staking pool at any given
@@ -168,3 +168,33 @@ func shuffled(v EthereumSigners) EthereumSigners {
 	})
 	return v
 }
+
+func TestEVMChainGravityDenom(t *testing.T) {
+	contract := gethcommon.HexToAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+
+	denom := EVMChainGravityDenom(137, contract)
+	assert.Equal(t, "gravity/137/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", denom)
+
+	evmChainID, erc20, err := EVMChainGravityDenomToERC20(denom)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(137), evmChainID)
+	assert.Equal(t, contract.Hex(), erc20)
+
+	// the default chain vouchers are not evm chain vouchers and vice versa
+	_, _, err = EVMChainGravityDenomToERC20(GravityDenom(contract))
+	assert.Error(t, err)
+	_, err = GravityDenomToERC20(denom)
+	assert.Error(t, err)
+
+	for _, invalid := range []string{
+		"gravity/0/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/0137/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+		"gravity/137/0x429881672B9AE42b8EbA0E26cD9C73711b891C",
+		"other/137/0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
+	} {
+		_, _, err = EVMChainGravityDenomToERC20(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	assert.Equal(t, denom, NormalizeDenom("gravity/137/0x429881672b9ae42b8eba0e26cd9c73711b891ca5"))
+}